@@ -41,6 +41,7 @@ const (
 	DaemonKindDropMergeCommit
 	DaemonKindMoveFixupCommitDown
 	DaemonKindWriteRebaseTodo
+	DaemonKindInsertExecTodos
 )
 
 const (
@@ -64,6 +65,7 @@ func getInstruction() Instruction {
 		DaemonKindMoveTodosDown:                   deserializeInstruction[*MoveTodosDownInstruction],
 		DaemonKindInsertBreak:                     deserializeInstruction[*InsertBreakInstruction],
 		DaemonKindWriteRebaseTodo:                 deserializeInstruction[*WriteRebaseTodoInstruction],
+		DaemonKindInsertExecTodos:                 deserializeInstruction[*InsertExecTodosInstruction],
 	}
 
 	return mapping[getDaemonKind()](jsonData)
@@ -379,6 +381,38 @@ func (self *InsertBreakInstruction) run(common *common.Common) error {
 	})
 }
 
+type InsertExecTodosInstruction struct {
+	Hashes  []string
+	Command string
+}
+
+func NewInsertExecTodosInstruction(hashes []string, command string) Instruction {
+	return &InsertExecTodosInstruction{
+		Hashes:  hashes,
+		Command: command,
+	}
+}
+
+func (self *InsertExecTodosInstruction) Kind() DaemonKind {
+	return DaemonKindInsertExecTodos
+}
+
+func (self *InsertExecTodosInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *InsertExecTodosInstruction) run(common *common.Common) error {
+	todosToFollow := lo.Map(self.Hashes, func(hash string, _ int) utils.Todo {
+		return utils.Todo{
+			Hash: hash,
+		}
+	})
+
+	return handleInteractiveRebase(common, func(path string) error {
+		return utils.InsertExecTodos(path, todosToFollow, self.Command, getCommentChar())
+	})
+}
+
 type WriteRebaseTodoInstruction struct {
 	TodosFileContent []byte
 }
@@ -40,6 +40,7 @@ type cliArgs struct {
 	Profile            bool
 	PrintDefaultConfig bool
 	PrintConfigDir     bool
+	Exec               *execArgs // nil unless the exec subcommand is used
 }
 
 type BuildInfo struct {
@@ -155,6 +156,14 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 		return
 	}
 
+	if cliArgs.Exec != nil {
+		if ok := runExec(appConfig, common, cliArgs.Exec, os.Stdout, os.Stderr); !ok {
+			os.RemoveAll(tempDir)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if cliArgs.Profile {
 		go func() {
 			if err := http.ListenAndServe("localhost:6060", nil); err != nil {
@@ -169,6 +178,14 @@ func Start(buildInfo *BuildInfo, integrationTest integrationTypes.IntegrationTes
 }
 
 func parseCliArgsAndEnvVars() *cliArgs {
+	if len(os.Args) > 1 && os.Args[1] == "exec" {
+		cliArgs, err := parseExecCliArgs(os.Args[2:])
+		if err != nil {
+			log.Fatal(err)
+		}
+		return cliArgs
+	}
+
 	flaggy.DefaultParser.ShowVersionWithVersionFlag = false
 
 	repoPath := ""
@@ -0,0 +1,351 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/integrii/flaggy"
+	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
+	"github.com/stefanhaller/git-todo-parser/todo"
+)
+
+// The arguments of `lazygit exec <action> <commit> [--to <commit>] [--json]`,
+// which runs a single operation on the commits of the current branch without
+// starting the GUI, so that scripts can reuse lazygit's rebase logic.
+type execArgs struct {
+	Action string
+	Commit string
+	// If set, the action applies to the range of commits between Commit and To
+	// (inclusive)
+	To   string
+	Json bool
+}
+
+type execAction struct {
+	name        string
+	description string
+	run         func(git *commands.GitCommand, commits []*models.Commit, startIdx int, endIdx int) error
+	// Returns an error if the action can't be applied to the given commits
+	validate func(tr *i18n.TranslationSet, commits []*models.Commit, startIdx int, endIdx int) error
+}
+
+var execActions = []execAction{
+	{
+		name:        "move-up",
+		description: "move the commits up (i.e. past the next newer commit)",
+		run: func(git *commands.GitCommand, commits []*models.Commit, startIdx int, endIdx int) error {
+			return git.Rebase.MoveCommitsUp(commits, startIdx, endIdx)
+		},
+		validate: func(tr *i18n.TranslationSet, commits []*models.Commit, startIdx int, endIdx int) error {
+			if startIdx == 0 {
+				return errors.New(tr.CannotMoveAnyFurther)
+			}
+			return nil
+		},
+	},
+	{
+		name:        "move-down",
+		description: "move the commits down (i.e. past the next older commit)",
+		run: func(git *commands.GitCommand, commits []*models.Commit, startIdx int, endIdx int) error {
+			return git.Rebase.MoveCommitsDown(commits, startIdx, endIdx)
+		},
+		validate: func(tr *i18n.TranslationSet, commits []*models.Commit, startIdx int, endIdx int) error {
+			if endIdx >= len(commits)-1 {
+				return errors.New(tr.CannotMoveAnyFurther)
+			}
+			return nil
+		},
+	},
+	{
+		name:        "drop",
+		description: "drop the commits",
+		run: func(git *commands.GitCommand, commits []*models.Commit, startIdx int, endIdx int) error {
+			if commits[startIdx].IsMerge() {
+				return git.Rebase.DropMergeCommit(commits, startIdx)
+			}
+			return git.Rebase.InteractiveRebase(commits, startIdx, endIdx, todo.Drop)
+		},
+		validate: func(tr *i18n.TranslationSet, commits []*models.Commit, startIdx int, endIdx int) error {
+			if startIdx != endIdx && lo.SomeBy(commits[startIdx:endIdx+1], func(c *models.Commit) bool { return c.IsMerge() }) {
+				return errors.New(tr.RangeSelectNotSupported)
+			}
+			return nil
+		},
+	},
+	{
+		name:        "fixup",
+		description: "fixup the commits into the commit below them",
+		run: func(git *commands.GitCommand, commits []*models.Commit, startIdx int, endIdx int) error {
+			return git.Rebase.InteractiveRebase(commits, startIdx, endIdx, todo.Fixup)
+		},
+		validate: func(tr *i18n.TranslationSet, commits []*models.Commit, startIdx int, endIdx int) error {
+			if endIdx >= len(commits)-1 {
+				return errors.New(tr.CannotSquashOrFixupFirstCommit)
+			}
+			if lo.SomeBy(commits[startIdx:endIdx+1], func(c *models.Commit) bool { return c.IsMerge() }) {
+				return errors.New(tr.CannotSquashOrFixupMergeCommit)
+			}
+			return nil
+		},
+	},
+	{
+		name:        "squash-fixups",
+		description: "squash all fixup! commits above the commit into their targets",
+		run: func(git *commands.GitCommand, commits []*models.Commit, startIdx int, endIdx int) error {
+			return git.Rebase.SquashAllAboveFixupCommits(commits[startIdx])
+		},
+		validate: func(tr *i18n.TranslationSet, commits []*models.Commit, startIdx int, endIdx int) error {
+			if startIdx != endIdx {
+				return errors.New(tr.RangeSelectNotSupported)
+			}
+			return nil
+		},
+	},
+}
+
+func execActionNames() []string {
+	return lo.Map(execActions, func(action execAction, _ int) string { return action.name })
+}
+
+func execActionsHelp() string {
+	lines := lo.Map(execActions, func(action execAction, _ int) string {
+		return fmt.Sprintf("    %-15s%s", action.name, action.description)
+	})
+	return "  Actions:\n" + strings.Join(lines, "\n")
+}
+
+type execResult struct {
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+	// True if the action stopped in the middle of a rebase (e.g. because of a
+	// conflict), in which case it needs to be resolved before continuing.
+	RebaseInProgress bool `json:"rebaseInProgress"`
+	// The commits of the current branch after running the action (limited to
+	// the most recent 300, like in the commits panel)
+	Commits []execCommit `json:"commits,omitempty"`
+}
+
+type execCommit struct {
+	Hash    string `json:"hash"`
+	Subject string `json:"subject"`
+	// One of "unpushed", "pushed", "merged" (i.e. merged into a main branch),
+	// or "rebasing"
+	Status string `json:"status"`
+	// The todo action (e.g. "pick") for commits that are part of an ongoing rebase
+	TodoAction string `json:"todoAction,omitempty"`
+}
+
+// The exec subcommand has its own parser, because flaggy doesn't allow a
+// subcommand in the same position as the git-arg positional value of the main
+// command
+func parseExecCliArgs(args []string) (*cliArgs, error) {
+	parser := flaggy.NewParser("lazygit exec")
+	parser.Description = "Run a single action on the commits of the current branch without starting the GUI, e.g. 'lazygit exec drop HEAD~2 --json'"
+	parser.ShowVersionWithVersionFlag = false
+	parser.AdditionalHelpAppend = execActionsHelp()
+
+	// The positional values are optional as far as flaggy is concerned, because
+	// they may also come after a '--' (which flaggy puts in the trailing
+	// arguments instead); we check that they are there ourselves below.
+	execArgs := &execArgs{}
+	parser.AddPositionalValue(&execArgs.Action, "action", 1, false, "The action to perform (see below)")
+	parser.AddPositionalValue(&execArgs.Commit, "commit", 2, false, "The commit to perform the action on, e.g. a hash or HEAD~2")
+	parser.String(&execArgs.To, "", "to", "Perform the action on the range of commits from <commit> to this one")
+	parser.Bool(&execArgs.Json, "", "json", "Print the result as JSON")
+
+	repoPath := ""
+	parser.String(&repoPath, "p", "path", "Path of git repo")
+
+	if err := parser.ParseArgs(args); err != nil {
+		return nil, err
+	}
+
+	trailingArgs := parser.TrailingArguments
+	for _, value := range []*string{&execArgs.Action, &execArgs.Commit} {
+		if *value == "" && len(trailingArgs) > 0 {
+			*value = trailingArgs[0]
+			trailingArgs = trailingArgs[1:]
+		}
+	}
+
+	if execArgs.Action == "" {
+		return nil, errors.New("Missing action. Run 'lazygit exec --help' to see the available actions")
+	}
+	if execArgs.Commit == "" {
+		return nil, errors.New("Missing commit. Usage: lazygit exec <action> <commit> [--to <commit>] [--json]")
+	}
+	if len(trailingArgs) > 0 {
+		return nil, fmt.Errorf("Unexpected arguments: %s", strings.Join(trailingArgs, " "))
+	}
+
+	return &cliArgs{
+		RepoPath: repoPath,
+		WorkTree: os.Getenv("GIT_WORK_TREE"),
+		GitDir:   os.Getenv("GIT_DIR"),
+		Debug:    os.Getenv("DEBUG") == "TRUE",
+		Exec:     execArgs,
+	}, nil
+}
+
+// Runs the action given on the command line and prints the result to stdout
+// (or, without --json, the error to stderr). Returns false if the action
+// failed, in which case lazygit exits with a non-zero exit code.
+func runExec(appConfig config.AppConfigurer, common *common.Common, args *execArgs, stdout io.Writer, stderr io.Writer) bool {
+	result := execResult{Action: args.Action}
+
+	err := execImpl(appConfig, common, args, &result)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	result.Success = err == nil
+
+	if args.Json {
+		output, jsonErr := json.MarshalIndent(result, "", "  ")
+		if jsonErr != nil {
+			fmt.Fprintln(stderr, jsonErr)
+			return false
+		}
+		fmt.Fprintln(stdout, string(output))
+	} else if err != nil {
+		fmt.Fprintln(stderr, err)
+	}
+
+	return result.Success
+}
+
+func execImpl(appConfig config.AppConfigurer, common *common.Common, args *execArgs, result *execResult) error {
+	action, ok := lo.Find(execActions, func(action execAction) bool { return action.name == args.Action })
+	if !ok {
+		return fmt.Errorf("Invalid action '%s'. Must be one of the following values: %s",
+			args.Action, strings.Join(execActionNames(), ", "))
+	}
+
+	app := &App{Common: common, Config: appConfig}
+	app.OSCommand = oscommands.NewOSCommand(common, appConfig, oscommands.GetPlatform(), oscommands.NewNullGuiIO(common.Log))
+
+	gitVersion, err := app.validateGitVersion()
+	if err != nil {
+		return err
+	}
+
+	git, err := commands.NewGitCommand(common, gitVersion, app.OSCommand, git_config.NewStdCachedGitConfig(common.Log))
+	if err != nil {
+		return err
+	}
+
+	commits, err := loadCommitsForExec(git, common, app.OSCommand)
+	if err != nil {
+		return err
+	}
+
+	// Whatever happens from now on, report the state of the branch afterwards
+	defer func() {
+		result.RebaseInProgress = git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE
+		if commits, err := loadCommitsForExec(git, common, app.OSCommand); err == nil {
+			result.Commits = commitsForExecResult(commits)
+		}
+	}()
+
+	if git.Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return errors.New(common.Tr.AlreadyRebasing)
+	}
+
+	startIdx, err := findCommitForExec(app.OSCommand, commits, args.Commit)
+	if err != nil {
+		return err
+	}
+	endIdx := startIdx
+	if args.To != "" {
+		endIdx, err = findCommitForExec(app.OSCommand, commits, args.To)
+		if err != nil {
+			return err
+		}
+	}
+	if startIdx > endIdx {
+		startIdx, endIdx = endIdx, startIdx
+	}
+
+	if err := action.validate(common.Tr, commits, startIdx, endIdx); err != nil {
+		return err
+	}
+
+	return action.run(git, commits, startIdx, endIdx)
+}
+
+func loadCommitsForExec(git *commands.GitCommand, common *common.Common, osCommand *oscommands.OSCommand) ([]*models.Commit, error) {
+	refForPushedStatus := "HEAD"
+	if branch, err := git.Branch.CurrentBranchInfo(); err == nil && !branch.DetachedHead {
+		refForPushedStatus = branch.RefName
+	}
+
+	return git.Loaders.CommitLoader.GetCommits(git_commands.GetCommitsOptions{
+		Limit:                true,
+		IncludeRebaseCommits: true,
+		RefName:              "HEAD",
+		RefForPushedStatus:   refForPushedStatus,
+		MainBranches:         git_commands.NewMainBranches(common, osCommand.Cmd),
+	})
+}
+
+// Resolves the given revision (e.g. a hash, or something like HEAD~2) and
+// returns the index of the corresponding commit
+func findCommitForExec(osCommand *oscommands.OSCommand, commits []*models.Commit, revision string) (int, error) {
+	cmdArgs := git_commands.NewGitCmd("rev-parse").
+		Arg("--verify", "--quiet", revision+"^{commit}").
+		ToArgv()
+	output, err := osCommand.Cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return -1, fmt.Errorf("Unknown commit '%s'", revision)
+	}
+
+	hash := strings.TrimSpace(output)
+	_, idx, ok := lo.FindIndexOf(commits, func(commit *models.Commit) bool {
+		return !commit.IsTODO() && commit.Hash == hash
+	})
+	if !ok {
+		return -1, fmt.Errorf("Commit '%s' is not one of the most recent commits of the current branch", revision)
+	}
+	return idx, nil
+}
+
+func commitsForExecResult(commits []*models.Commit) []execCommit {
+	return lo.Map(commits, func(commit *models.Commit, _ int) execCommit {
+		status := ""
+		switch commit.Status {
+		case models.StatusUnpushed:
+			status = "unpushed"
+		case models.StatusPushed:
+			status = "pushed"
+		case models.StatusMerged:
+			status = "merged"
+		case models.StatusRebasing:
+			status = "rebasing"
+		}
+
+		todoAction := ""
+		if commit.IsTODO() {
+			todoAction = lo.Ternary(commit.Action == models.ActionConflict, "conflict", commit.Action.String())
+		}
+
+		return execCommit{
+			Hash:       commit.Hash,
+			Subject:    commit.Name,
+			Status:     status,
+			TodoAction: todoAction,
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	// Interactive rebases run the current executable as git's sequence editor,
+	// which in the tests below is the test binary, so it needs to act as the
+	// daemon when called that way
+	daemon.Handle(utils.NewDummyCommon())
+
+	os.Exit(m.Run())
+}
+
+func TestParseExecCliArgs(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		args             []string
+		expectedExecArgs *execArgs
+		expectedRepoPath string
+		expectedErr      string
+	}{
+		{
+			testName:         "action and commit",
+			args:             []string{"drop", "HEAD~2"},
+			expectedExecArgs: &execArgs{Action: "drop", Commit: "HEAD~2"},
+		},
+		{
+			testName:         "all flags",
+			args:             []string{"fixup", "abc123", "--to", "def456", "--json", "-p", "/path/to/repo"},
+			expectedExecArgs: &execArgs{Action: "fixup", Commit: "abc123", To: "def456", Json: true},
+			expectedRepoPath: "/path/to/repo",
+		},
+		{
+			testName:         "flags before positional values",
+			args:             []string{"--json", "--path", "/path/to/repo", "move-up", "abc123"},
+			expectedExecArgs: &execArgs{Action: "move-up", Commit: "abc123", Json: true},
+			expectedRepoPath: "/path/to/repo",
+		},
+		{
+			testName:    "no arguments",
+			args:        []string{},
+			expectedErr: "Missing action. Run 'lazygit exec --help' to see the available actions",
+		},
+		{
+			testName:    "missing commit",
+			args:        []string{"drop", "--json"},
+			expectedErr: "Missing commit. Usage: lazygit exec <action> <commit> [--to <commit>] [--json]",
+		},
+		{
+			testName:         "commit after --",
+			args:             []string{"drop", "--json", "--", "HEAD~2"},
+			expectedExecArgs: &execArgs{Action: "drop", Commit: "HEAD~2", Json: true},
+		},
+		{
+			testName:         "action and commit after --",
+			args:             []string{"--", "drop", "HEAD~2"},
+			expectedExecArgs: &execArgs{Action: "drop", Commit: "HEAD~2"},
+		},
+		{
+			testName:         "flags after -- are positional values",
+			args:             []string{"drop", "--", "--json"},
+			expectedExecArgs: &execArgs{Action: "drop", Commit: "--json"},
+		},
+		{
+			testName:    "missing commit after --",
+			args:        []string{"--", "drop"},
+			expectedErr: "Missing commit. Usage: lazygit exec <action> <commit> [--to <commit>] [--json]",
+		},
+		{
+			testName:    "too many arguments after --",
+			args:        []string{"drop", "--", "HEAD~2", "HEAD~3"},
+			expectedErr: "Unexpected arguments: HEAD~3",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			cliArgs, err := parseExecCliArgs(s.args)
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+				assert.Nil(t, cliArgs)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedExecArgs, cliArgs.Exec)
+			assert.Equal(t, s.expectedRepoPath, cliArgs.RepoPath)
+		})
+	}
+}
+
+func TestRunExec(t *testing.T) {
+	scenarios := []struct {
+		testName        string
+		args            *execArgs
+		expectedSuccess bool
+		expectedStdout  string
+		expectedStderr  string
+	}{
+		{
+			testName:        "invalid action",
+			args:            &execArgs{Action: "invalid", Commit: "HEAD"},
+			expectedSuccess: false,
+			expectedStdout:  "",
+			expectedStderr:  "Invalid action 'invalid'. Must be one of the following values: move-up, move-down, drop, fixup, squash-fixups\n",
+		},
+		{
+			testName:        "invalid action with json output",
+			args:            &execArgs{Action: "invalid", Commit: "HEAD", Json: true},
+			expectedSuccess: false,
+			expectedStdout: `{
+  "action": "invalid",
+  "success": false,
+  "error": "Invalid action 'invalid'. Must be one of the following values: move-up, move-down, drop, fixup, squash-fixups",
+  "rebaseInProgress": false
+}
+`,
+			expectedStderr: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			success := runExec(config.NewDummyAppConfig(), utils.NewDummyCommon(), s.args, stdout, stderr)
+
+			assert.Equal(t, s.expectedSuccess, success)
+			assert.Equal(t, s.expectedStdout, stdout.String())
+			assert.Equal(t, s.expectedStderr, stderr.String())
+		})
+	}
+}
+
+func TestRunExecRebaseActions(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		args             *execArgs
+		expectedSubjects []string
+	}{
+		{
+			testName:         "move-up",
+			args:             &execArgs{Action: "move-up", Commit: "HEAD~1", Json: true},
+			expectedSubjects: []string{"three", "fixup! two", "two", "one"},
+		},
+		{
+			testName:         "move-down",
+			args:             &execArgs{Action: "move-down", Commit: "HEAD~1", Json: true},
+			expectedSubjects: []string{"fixup! two", "two", "three", "one"},
+		},
+		{
+			testName:         "drop a range",
+			args:             &execArgs{Action: "drop", Commit: "HEAD~2", To: "HEAD~1", Json: true},
+			expectedSubjects: []string{"fixup! two", "one"},
+		},
+		{
+			testName:         "fixup",
+			args:             &execArgs{Action: "fixup", Commit: "HEAD~1", Json: true},
+			expectedSubjects: []string{"fixup! two", "two", "one"},
+		},
+		{
+			testName:         "squash-fixups",
+			args:             &execArgs{Action: "squash-fixups", Commit: "HEAD~2", Json: true},
+			expectedSubjects: []string{"three", "two", "one"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			setUpExecTestRepo(t, "one", "two", "three", "fixup! two")
+
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			appState := &config.AppState{GitLogOrder: "topo-order"}
+			common := utils.NewDummyCommonWithUserConfigAndAppState(config.GetDefaultConfig(), appState)
+			success := runExec(config.NewDummyAppConfig(), common, s.args, stdout, stderr)
+
+			assert.True(t, success)
+			assert.Equal(t, "", stderr.String())
+			assert.Equal(t, s.expectedSubjects, strings.Split(runGitForExecTest(t, "log", "--format=%s"), "\n"))
+
+			var result execResult
+			assert.NoError(t, json.Unmarshal(stdout.Bytes(), &result))
+			assert.Equal(t, s.args.Action, result.Action)
+			assert.True(t, result.Success)
+			assert.False(t, result.RebaseInProgress)
+			assert.Equal(t, s.expectedSubjects, lo.Map(result.Commits, func(commit execCommit, _ int) string { return commit.Subject }))
+			assert.Equal(t, runGitForExecTest(t, "rev-parse", "HEAD"), result.Commits[0].Hash)
+			// The feature branch has no upstream, so its commits count as pushed
+			expectedStatuses := append(lo.Times(len(s.expectedSubjects)-1, func(int) string { return "pushed" }), "merged")
+			assert.Equal(t, expectedStatuses, lo.Map(result.Commits, func(commit execCommit, _ int) string { return commit.Status }))
+		})
+	}
+}
+
+// Creates a repo in a temporary directory with one commit (each adding its
+// own file) per given subject, and makes it the current directory. The first
+// commit is on master, the others on a feature branch.
+func setUpExecTestRepo(t *testing.T, subjects ...string) {
+	dir := t.TempDir()
+	globalConfig := filepath.Join(t.TempDir(), "gitconfig")
+	assert.NoError(t, os.WriteFile(globalConfig, []byte("[user]\n\tname = Test\n\temail = test@example.com\n"), 0o644))
+	t.Setenv("GIT_CONFIG_GLOBAL", globalConfig)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	cwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(cwd) })
+
+	runGitForExecTest(t, "init", "-b", "master")
+	for i, subject := range subjects {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, fmt.Sprintf("file%d", i)), []byte(subject), 0o644))
+		runGitForExecTest(t, "add", "-A")
+		runGitForExecTest(t, "commit", "-m", subject)
+		if i == 0 {
+			runGitForExecTest(t, "checkout", "-b", "feature")
+		}
+	}
+}
+
+func runGitForExecTest(t *testing.T, args ...string) string {
+	output, err := exec.Command("git", args...).CombinedOutput()
+	assert.NoError(t, err, string(output))
+	return strings.TrimSpace(string(output))
+}
@@ -105,6 +105,10 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"status":            tr.StatusTitle,
 		"submodules":        tr.SubmodulesTitle,
 		"subCommits":        tr.SubCommitsTitle,
+		"rangeDiff":         tr.RangeDiffTitle,
+		"operationHistory":  tr.OperationHistoryTitle,
+		"branchReflog":      tr.BranchReflogTitle,
+		"customList":        tr.CustomListTitle,
 		"remoteBranches":    tr.RemoteBranchesTitle,
 		"remotes":           tr.RemotesTitle,
 		"reflogCommits":     tr.ReflogCommitsTitle,
@@ -118,6 +122,7 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"main":              tr.NormalTitle,
 		"patchBuilding":     tr.PatchBuildingTitle,
 		"mergeConflicts":    tr.MergingTitle,
+		"blame":             tr.BlameTitle,
 		"staging":           tr.StagingTitle,
 		"menu":              tr.MenuTitle,
 		"search":            tr.SearchTitle,
@@ -2,6 +2,10 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 )
 
 type BlameCommands struct {
@@ -31,3 +35,105 @@ func (self *BlameCommands) BlameLineRange(filename string, commit string, firstL
 
 	return self.cmd.New(cmdArgs.ToArgv()).RunWithOutput()
 }
+
+// Blame a whole file at the given commit, using the porcelain format so that
+// we can group lines by the commit they came from. If commit is empty, the
+// working tree version of the file is blamed, in which case uncommitted lines
+// are attributed to models.NotCommittedYetHash.
+func (self *BlameCommands) BlameFile(filename string, commit string) ([]*models.BlameLine, error) {
+	cmdArgs := NewGitCmd("blame").
+		Arg("--porcelain").
+		ArgIf(commit != "", commit).
+		Arg("--").
+		Arg(filename).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlamePorcelain(output), nil
+}
+
+// Example output (header fields of a commit are only printed the first time
+// the commit appears, and the last number of the first line of a group is
+// the number of lines in the group):
+//
+//	d25769eb80b0a0522be1ce4ecf79fd5127a8b52b 2 2 2
+//	author Stefan Haller
+//	author-mail <stefan@haller-berlin.de>
+//	author-time 1690894496
+//	author-tz +0200
+//	committer Stefan Haller
+//	committer-mail <stefan@haller-berlin.de>
+//	committer-time 1690894496
+//	committer-tz +0200
+//	summary Add blame command
+//	previous 53f650e253f94c2a70f05dc91bd7e4390ced33dc blame.go
+//	filename blame.go
+//		func NewBlameCommands(gitCommon *GitCommon) *BlameCommands {
+//	d25769eb80b0a0522be1ce4ecf79fd5127a8b52b 3 3
+//		return &BlameCommands{
+func parseBlamePorcelain(output string) []*models.BlameLine {
+	commitsByHash := map[string]*models.BlameCommit{}
+	lines := []*models.BlameLine{}
+
+	var currentLine *models.BlameLine
+	var previousCommit *models.BlameCommit
+	for _, line := range strings.Split(output, "\n") {
+		if currentLine == nil {
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+
+			hash := fields[0]
+			commit, ok := commitsByHash[hash]
+			if !ok {
+				commit = &models.BlameCommit{Hash: hash}
+				commitsByHash[hash] = commit
+			}
+
+			originalLineNumber, _ := strconv.Atoi(fields[1])
+			lineNumber, _ := strconv.Atoi(fields[2])
+			currentLine = &models.BlameLine{
+				Commit:             commit,
+				OriginalLineNumber: originalLineNumber,
+				LineNumber:         lineNumber,
+				IsFirstInGroup:     commit != previousCommit,
+			}
+			continue
+		}
+
+		if content, ok := strings.CutPrefix(line, "\t"); ok {
+			currentLine.Content = content
+			lines = append(lines, currentLine)
+			previousCommit = currentLine.Commit
+			currentLine = nil
+			continue
+		}
+
+		commit := currentLine.Commit
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "author":
+			commit.AuthorName = value
+		case "author-mail":
+			commit.AuthorEmail = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+		case "author-time":
+			unixTimestamp, _ := strconv.Atoi(value)
+			commit.UnixTimestamp = int64(unixTimestamp)
+		case "summary":
+			commit.Summary = value
+		case "previous":
+			commit.PreviousHash, commit.PreviousFilename, _ = strings.Cut(value, " ")
+		case "boundary":
+			commit.IsBoundary = true
+		case "filename":
+			commit.Filename = value
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,104 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlameFile(t *testing.T) {
+	output := `53f650e253f94c2a70f05dc91bd7e4390ced33dc 1 1 1
+author Jesse Duffield
+author-mail <jesse@example.com>
+author-time 1690894000
+author-tz +0000
+committer Jesse Duffield
+committer-mail <jesse@example.com>
+committer-time 1690894000
+committer-tz +0000
+summary initial commit
+boundary
+filename file.txt
+	first line
+d25769eb80b0a0522be1ce4ecf79fd5127a8b52b 2 2 2
+author Stefan Haller
+author-mail <stefan@example.com>
+author-time 1690894496
+author-tz +0200
+committer Stefan Haller
+committer-mail <stefan@example.com>
+committer-time 1690894496
+committer-tz +0200
+summary change second line
+previous 53f650e253f94c2a70f05dc91bd7e4390ced33dc old.txt
+filename file.txt
+	second line
+d25769eb80b0a0522be1ce4ecf79fd5127a8b52b 3 3
+	third line
+53f650e253f94c2a70f05dc91bd7e4390ced33dc 2 4 1
+filename file.txt
+	fourth line
+`
+
+	firstCommit := &models.BlameCommit{
+		Hash:          "53f650e253f94c2a70f05dc91bd7e4390ced33dc",
+		AuthorName:    "Jesse Duffield",
+		AuthorEmail:   "jesse@example.com",
+		UnixTimestamp: 1690894000,
+		Summary:       "initial commit",
+		Filename:      "file.txt",
+		IsBoundary:    true,
+	}
+	secondCommit := &models.BlameCommit{
+		Hash:             "d25769eb80b0a0522be1ce4ecf79fd5127a8b52b",
+		AuthorName:       "Stefan Haller",
+		AuthorEmail:      "stefan@example.com",
+		UnixTimestamp:    1690894496,
+		Summary:          "change second line",
+		Filename:         "file.txt",
+		PreviousHash:     "53f650e253f94c2a70f05dc91bd7e4390ced33dc",
+		PreviousFilename: "old.txt",
+	}
+
+	type scenario struct {
+		testName     string
+		commit       string
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "working tree",
+			commit:       "",
+			expectedArgs: []string{"blame", "--porcelain", "--", "file.txt"},
+		},
+		{
+			testName:     "at commit",
+			commit:       "abc123",
+			expectedArgs: []string{"blame", "--porcelain", "abc123", "--", "file.txt"},
+		},
+	}
+
+	expectedLines := []*models.BlameLine{
+		{Commit: firstCommit, LineNumber: 1, OriginalLineNumber: 1, Content: "first line", IsFirstInGroup: true},
+		{Commit: secondCommit, LineNumber: 2, OriginalLineNumber: 2, Content: "second line", IsFirstInGroup: true},
+		{Commit: secondCommit, LineNumber: 3, OriginalLineNumber: 3, Content: "third line", IsFirstInGroup: false},
+		{Commit: firstCommit, LineNumber: 4, OriginalLineNumber: 2, Content: "fourth line", IsFirstInGroup: true},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, output, nil)
+			instance := buildBlameCommands(commonDeps{runner: runner})
+
+			lines, err := instance.BlameFile("file.txt", s.commit)
+			assert.NoError(t, err)
+			assert.EqualValues(t, expectedLines, lines)
+			// lines from the same commit share the same commit object
+			assert.Same(t, lines[0].Commit, lines[3].Commit)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
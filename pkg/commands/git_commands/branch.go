@@ -173,6 +173,18 @@ func (self *BranchCommands) SetUpstream(remoteName string, remoteBranchName stri
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// SetUpstreamRef makes the branch track a ref on the remote that isn't a
+// branch, e.g. refs/pull/123/head. git branch --set-upstream-to only accepts
+// remote-tracking branches, so we write the config ourselves; this is also what
+// e.g. `gh pr checkout` does.
+func (self *BranchCommands) SetUpstreamRef(branchName string, remoteName string, ref string) error {
+	if err := self.cmd.New(NewGitCmd("config").Arg("branch."+branchName+".remote", remoteName).ToArgv()).Run(); err != nil {
+		return err
+	}
+
+	return self.cmd.New(NewGitCmd("config").Arg("branch."+branchName+".merge", ref).ToArgv()).Run()
+}
+
 func (self *BranchCommands) UnsetUpstream(branchName string) error {
 	cmdArgs := NewGitCmd("branch").Arg("--unset-upstream", branchName).
 		ToArgv()
@@ -226,6 +238,58 @@ func (self *BranchCommands) Rename(oldName string, newName string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// MoveTo points the given branch at the given ref. The branch must not be
+// checked out.
+func (self *BranchCommands) MoveTo(branchName string, ref string) error {
+	cmdArgs := NewGitCmd("branch").
+		Arg("--force", branchName, ref).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// GetRefsSnapshot returns the current position of all local branches, along
+// with which one is checked out (or the hash of HEAD if it is detached).
+func (self *BranchCommands) GetRefsSnapshot() (*models.RefsSnapshot, error) {
+	// refname:short would be ambiguous (e.g. "heads/foo") if there's a tag or
+	// remote with the same name as a branch
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(HEAD)%00%(refname:lstrip=2)%00%(objectname)").
+		Arg("refs/heads").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &models.RefsSnapshot{Branches: map[string]string{}}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		snapshot.Branches[fields[1]] = fields[2]
+		if fields[0] == "*" {
+			snapshot.CheckedOutBranch = fields[1]
+			snapshot.Head = fields[2]
+		}
+	}
+
+	if snapshot.CheckedOutBranch == "" {
+		// Detached HEAD (or no commits yet, in which case this fails and we
+		// leave Head empty)
+		output, err := self.cmd.New(NewGitCmd("rev-parse").Arg("--verify", "--quiet", "HEAD").ToArgv()).
+			DontLog().RunWithOutput()
+		if err == nil {
+			snapshot.Head = strings.TrimSpace(output)
+		}
+	}
+
+	return snapshot, nil
+}
+
 type MergeOpts struct {
 	FastForwardOnly bool
 	Squash          bool
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -60,6 +61,78 @@ func TestBranchNewBranch(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestBranchSetUpstreamRef(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "branch.pr/5.remote", "origin"}, "", nil).
+		ExpectGitArgs([]string{"config", "branch.pr/5.merge", "refs/pull/5/head"}, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetUpstreamRef("pr/5", "origin", "refs/pull/5/head"))
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchMoveTo(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"branch", "--force", "feature", "abc123"}, "", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.MoveTo("feature", "abc123"))
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetRefsSnapshot(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected *models.RefsSnapshot
+	}{
+		{
+			testName: "branch checked out",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--format=%(HEAD)%00%(refname:lstrip=2)%00%(objectname)", "refs/heads"},
+					" \x00feature\x00aaa\n*\x00master\x00bbb\n", nil),
+			expected: &models.RefsSnapshot{
+				CheckedOutBranch: "master",
+				Head:             "bbb",
+				Branches:         map[string]string{"feature": "aaa", "master": "bbb"},
+			},
+		},
+		{
+			testName: "branch with the same name as a tag",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--format=%(HEAD)%00%(refname:lstrip=2)%00%(objectname)", "refs/heads"},
+					"*\x00master\x00bbb\n \x00v1.0\x00aaa\n \x00feature/v1.0\x00ccc\n", nil),
+			expected: &models.RefsSnapshot{
+				CheckedOutBranch: "master",
+				Head:             "bbb",
+				Branches:         map[string]string{"master": "bbb", "v1.0": "aaa", "feature/v1.0": "ccc"},
+			},
+		},
+		{
+			testName: "detached head",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--format=%(HEAD)%00%(refname:lstrip=2)%00%(objectname)", "refs/heads"},
+					" \x00master\x00bbb\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD"}, "ccc\n", nil),
+			expected: &models.RefsSnapshot{
+				CheckedOutBranch: "",
+				Head:             "ccc",
+				Branches:         map[string]string{"master": "bbb"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner})
+			snapshot, err := instance.GetRefsSnapshot()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, snapshot)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestBranchDeleteBranch(t *testing.T) {
 	type scenario struct {
 		testName    string
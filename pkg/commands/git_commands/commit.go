@@ -165,6 +165,38 @@ func (self *CommitCommands) GetCommitMessage(commitHash string) (string, error)
 	return strings.ReplaceAll(strings.TrimSpace(message), "\r\n", "\n"), err
 }
 
+// Returns the `git log --oneline` output of the non-merge commits that are
+// reachable from to but not from from, oldest first
+func (self *CommitCommands) GetCommitsOnelineBetween(from string, to string) (string, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--oneline", "--no-decorate", "--reverse", "--no-merges", from+".."+to).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+}
+
+// Returns the full messages of the non-merge commits that are reachable from
+// to but not from from, oldest first
+func (self *CommitCommands) GetCommitMessagesBetween(from string, to string) ([]string, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--format=%B%x00", "--reverse", "--no-merges", from+".."+to).
+		Config("log.showsignature=false").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []string{}
+	for _, message := range strings.Split(output, "\x00") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, strings.ReplaceAll(message, "\r\n", "\n"))
+		}
+	}
+	return messages, nil
+}
+
 func (self *CommitCommands) GetCommitSubject(commitHash string) (string, error) {
 	cmdArgs := NewGitCmd("log").
 		Arg("--format=%s", "--max-count=1", commitHash).
@@ -268,6 +300,9 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPath string) oscommand
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		ArgIf(self.UserConfig().Git.Log.ShowSignatures, "--show-signature").
+		// git shows the notes of its default notes ref by itself
+		ArgIf(self.UserConfig().Git.NotesRef != "", "--notes="+getNotesRef(self.UserConfig(), self.config)).
 		Arg("-p").
 		Arg(hash).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
@@ -4,20 +4,16 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
-	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
-	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/samber/lo"
 )
 
 type CommitFileLoader struct {
-	*common.Common
-	cmd oscommands.ICmdObjBuilder
+	*GitCommon
 }
 
-func NewCommitFileLoader(common *common.Common, cmd oscommands.ICmdObjBuilder) *CommitFileLoader {
+func NewCommitFileLoader(gitCommon *GitCommon) *CommitFileLoader {
 	return &CommitFileLoader{
-		Common: common,
-		cmd:    cmd,
+		GitCommon: gitCommon,
 	}
 }
 
@@ -40,7 +36,28 @@ func (self *CommitFileLoader) GetFilesInDiff(from string, to string, reverse boo
 		return nil, err
 	}
 
-	return getCommitFilesFromFilenames(filenames), nil
+	files := getCommitFilesFromFilenames(filenames)
+	if usesLfs(self.Fs, self.repoPaths) {
+		self.setLfsFields(files, to)
+	}
+
+	return files, nil
+}
+
+func (self *CommitFileLoader) setLfsFields(files []*models.CommitFile, to string) {
+	// The attributes at the commit being viewed are what matter, but git can
+	// only read them from a tree since 2.40; before that we fall back to the
+	// ones in the working tree
+	source := ""
+	if self.version.IsAtLeast(2, 40, 0) {
+		source = to
+	}
+
+	paths := lo.Map(files, func(file *models.CommitFile, _ int) string { return file.Name })
+	trackedPaths := lfsTrackedPaths(self.cmd, self.Log, paths, source)
+	for _, file := range files {
+		file.IsLfs = trackedPaths[file.Name]
+	}
 }
 
 // filenames string is something like "MM\x00file1\x00MU\x00file2\x00AA\x00file3\x00"
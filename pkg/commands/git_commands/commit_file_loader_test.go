@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +72,60 @@ func TestGetCommitFilesFromFilenames(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFilesInDiffLfs(t *testing.T) {
+	diffArgs := []string{"-c", "diff.noprefix=false", "diff", "--submodule", "--no-ext-diff", "--name-status", "-z", "--no-renames", "abc^", "abc"}
+	diffOutput := "M\x00image.psd\x00M\x00main.go\x00"
+
+	type scenario struct {
+		testName      string
+		gitAttributes string
+		gitVersion    *GitVersion
+		runner        *oscommands.FakeCmdObjRunner
+		expectedLfs   []bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "repo doesn't use lfs",
+			gitAttributes: "*.go diff=golang\n",
+			gitVersion:    &GitVersion{2, 40, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(diffArgs, diffOutput, nil),
+			expectedLfs: []bool{false, false},
+		},
+		{
+			testName:      "attributes are read from the commit",
+			gitAttributes: "*.psd filter=lfs diff=lfs merge=lfs -text\n",
+			gitVersion:    &GitVersion{2, 40, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(diffArgs, diffOutput, nil).
+				ExpectGitArgs([]string{"check-attr", "--stdin", "-z", "--source=abc", "filter"},
+					"image.psd\x00filter\x00lfs\x00main.go\x00filter\x00unspecified\x00", nil),
+			expectedLfs: []bool{true, false},
+		},
+		{
+			testName:      "older git reads attributes from the working tree",
+			gitAttributes: "*.psd filter=lfs diff=lfs merge=lfs -text\n",
+			gitVersion:    &GitVersion{2, 39, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(diffArgs, diffOutput, nil).
+				ExpectGitArgs([]string{"check-attr", "--stdin", "-z", "filter"},
+					"image.psd\x00filter\x00lfs\x00main.go\x00filter\x00unspecified\x00", nil),
+			expectedLfs: []bool{true, false},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			assert.NoError(t, afero.WriteFile(fs, ".gitattributes", []byte(s.gitAttributes), 0o644))
+			instance := buildCommitFileLoader(commonDeps{runner: s.runner, fs: fs, repoPaths: MockRepoPaths(""), gitVersion: s.gitVersion})
+
+			files, err := instance.GetFilesInDiff("abc^", "abc", false)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedLfs, lo.Map(files, func(file *models.CommitFile, _ int) bool { return file.IsLfs }))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
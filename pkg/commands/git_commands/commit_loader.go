@@ -85,14 +85,15 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 
 	wg := sync.WaitGroup{}
 
-	wg.Add(2)
+	wg.Add(3)
 
 	var logErr error
 	go utils.Safe(func() {
 		defer wg.Done()
 
+		showSignatures := self.UserConfig().Git.Log.ShowSignatures
 		logErr = self.getLogCmd(opts).RunAndProcessLines(func(line string) (bool, error) {
-			commit := self.extractCommitFromLine(line, opts.RefToShowDivergenceFrom != "")
+			commit := self.extractCommitFromLine(line, opts.RefToShowDivergenceFrom != "", showSignatures)
 			commits = append(commits, commit)
 			return false, nil
 		})
@@ -109,6 +110,13 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		}
 	})
 
+	var notedHashes map[string]bool
+	go utils.Safe(func() {
+		defer wg.Done()
+
+		notedHashes = self.getNotedHashes()
+	})
+
 	passedFirstPushedCommit := false
 	// I can get this before
 	firstPushedCommit, err := self.getFirstPushedCommit(opts.RefForPushedStatus)
@@ -124,6 +132,7 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	}
 
 	for _, commit := range commits {
+		commit.HasNote = notedHashes[commit.Hash]
 		if commit.Hash == firstPushedCommit {
 			passedFirstPushedCommit = true
 		}
@@ -162,6 +171,27 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	return commits, nil
 }
 
+// getNotedHashes returns the set of objects that have a note attached in the
+// notes ref that we show notes from. Errors are ignored because not having any
+// notes is the common case and shouldn't prevent us from showing commits.
+func (self *CommitLoader) getNotedHashes() map[string]bool {
+	cmdArgs := NewGitCmd("notes").Arg("--ref="+getNotesRef(self.UserConfig(), self.config), "list").ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	result := map[string]bool{}
+	for _, line := range utils.SplitLines(output) {
+		// each line is "<note blob> <annotated object>"
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			result[fields[1]] = true
+		}
+	}
+	return result
+}
+
 func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*models.Commit, error) {
 	// chances are we have as many commits as last time so we'll set the capacity to be the old length
 	result := make([]*models.Commit, 0, len(commits))
@@ -197,8 +227,11 @@ func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*mod
 // then puts them into a commit object
 // example input:
 // 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|10 hours ago|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|refresh commits when adding a tag
-func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool) *models.Commit {
-	split := strings.SplitN(line, "\x00", 8)
+// If showSignatures is true, the line also contains the signature status and
+// signer before the message (see prettyFormatWithSignatures)
+func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool, showSignatures bool) *models.Commit {
+	fieldCount := lo.Ternary(showSignatures, 10, 8)
+	split := strings.SplitN(line, "\x00", fieldCount)
 
 	hash := split[0]
 	unixTimestamp := split[1]
@@ -210,7 +243,13 @@ func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool
 	if showDivergence {
 		divergence = lo.Ternary(split[6] == "<", models.DivergenceLeft, models.DivergenceRight)
 	}
-	message := split[7]
+	signatureStatus := models.SignatureStatusUnknown
+	signer := ""
+	if showSignatures {
+		signatureStatus = parseSignatureStatus(split[7])
+		signer = split[8]
+	}
+	message := split[fieldCount-1]
 
 	tags := []string{}
 
@@ -236,15 +275,36 @@ func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool
 	}
 
 	return &models.Commit{
-		Hash:          hash,
-		Name:          message,
-		Tags:          tags,
-		ExtraInfo:     extraInfo,
-		UnixTimestamp: int64(unitTimestampInt),
-		AuthorName:    authorName,
-		AuthorEmail:   authorEmail,
-		Parents:       parents,
-		Divergence:    divergence,
+		Hash:            hash,
+		Name:            message,
+		Tags:            tags,
+		ExtraInfo:       extraInfo,
+		UnixTimestamp:   int64(unitTimestampInt),
+		AuthorName:      authorName,
+		AuthorEmail:     authorEmail,
+		Parents:         parents,
+		Divergence:      divergence,
+		SignatureStatus: signatureStatus,
+		Signer:          signer,
+	}
+}
+
+func parseSignatureStatus(code string) models.SignatureStatus {
+	switch code {
+	case "G":
+		return models.SignatureStatusGood
+	case "U":
+		return models.SignatureStatusUntrusted
+	case "X", "Y":
+		return models.SignatureStatusExpired
+	case "R":
+		return models.SignatureStatusRevoked
+	case "B":
+		return models.SignatureStatusBad
+	case "E":
+		return models.SignatureStatusUnverifiable
+	default:
+		return models.SignatureStatusNone
 	}
 }
 
@@ -271,7 +331,7 @@ func (self *CommitLoader) getHydratedRebasingCommits(rebaseMode enums.RebaseMode
 
 	fullCommits := map[string]*models.Commit{}
 	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
-		commit := self.extractCommitFromLine(line, false)
+		commit := self.extractCommitFromLine(line, false, false)
 		fullCommits[commit.Hash] = commit
 		return false, nil
 	})
@@ -341,20 +401,26 @@ func (self *CommitLoader) getRebasingCommits(rebaseMode enums.RebaseMode) []*mod
 		})
 	}
 
+	occurrences := map[string]int{}
 	for _, t := range todos {
+		occurrence := 0
 		if t.Command == todo.UpdateRef {
 			t.Msg = t.Ref
-		} else if t.Command == todo.Exec {
-			t.Msg = t.ExecCommand
+		} else if t.Commit == "" && utils.IsRenderedNonCommitTodo(t.Command) && !utils.IsOntoTodo(t) {
+			t.Msg = utils.TodoText(t)
+			key := fmt.Sprintf("%s %s", t.Command, t.Msg)
+			occurrence = occurrences[key]
+			occurrences[key]++
 		} else if t.Commit == "" {
 			// Command does not have a commit associated, skip
 			continue
 		}
 		commits = utils.Prepend(commits, &models.Commit{
-			Hash:   t.Commit,
-			Name:   t.Msg,
-			Status: models.StatusRebasing,
-			Action: t.Command,
+			Hash:           t.Commit,
+			Name:           t.Msg,
+			Status:         models.StatusRebasing,
+			Action:         t.Command,
+			TodoOccurrence: occurrence,
 		})
 	}
 
@@ -510,7 +576,7 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		ArgIf(gitLogOrder != "default", "--"+gitLogOrder).
 		ArgIf(opts.All, "--all").
 		Arg("--oneline").
-		Arg(prettyFormat).
+		ArgIfElse(self.UserConfig().Git.Log.ShowSignatures, prettyFormatWithSignatures, prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
 		ArgIf(opts.Limit, "-300").
@@ -525,3 +591,7 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 }
 
 const prettyFormat = `--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s`
+
+// Same as prettyFormat, but with the signature status and the signer's name
+// before the subject. Asking for these makes git verify each signature.
+const prettyFormatWithSignatures = `--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%G?%x00%GS%x00%s`
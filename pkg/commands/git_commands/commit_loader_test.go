@@ -24,6 +24,10 @@ d8084cd558925eb7c9c38afeed5725c21653ab90|1640821426|Jesse Duffield|jessedduffiel
 3d4470a6c072208722e5ae9a54bcb9634959a1c5|1640748818|Jesse Duffield|jessedduffield@gmail.com||053a66a7be3da43aacdc|>|WIP
 053a66a7be3da43aacdc7aa78e1fe757b82c4dd2|1640739815|Jesse Duffield|jessedduffield@gmail.com||985fe482e806b172aea4|>|refactoring the config struct`, "|", "\x00", -1)
 
+var commitsWithSignaturesOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|HEAD -> better-tests|b21997d6b4cbdf84b149|>|N||better typing for rebase mode
+b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164|1640824515|Jesse Duffield|jessedduffield@gmail.com||e94e8fc5b6fab4cb755f|>|G|Jesse Duffield <jessedduffield@gmail.com>|fix logging
+e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c|1640823749|Jesse Duffield|jessedduffield@gmail.com||d8084cd558925eb7c9c3|>|B|Jesse Duffield <jessedduffield@gmail.com>|refactor`, "|", "\x00", -1)
+
 var singleCommitOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|HEAD -> better-tests|b21997d6b4cbdf84b149|>|better typing for rebase mode`, "|", "\x00", -1)
 
 func TestGetCommits(t *testing.T) {
@@ -36,6 +40,7 @@ func TestGetCommits(t *testing.T) {
 		rebaseMode      enums.RebaseMode
 		opts            GetCommitsOptions
 		mainBranches    []string
+		showSignatures  bool
 	}
 
 	scenarios := []scenario{
@@ -46,7 +51,8 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
-				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
+				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
@@ -58,7 +64,8 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "refs/heads/mybranch", RefForPushedStatus: "refs/heads/mybranch", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "refs/heads/mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
-				ExpectGitArgs([]string{"log", "refs/heads/mybranch", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
+				ExpectGitArgs([]string{"log", "refs/heads/mybranch", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
@@ -74,6 +81,7 @@ func TestGetCommits(t *testing.T) {
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, commitsOutput, nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "9c1d1ebd25b9bb2dd7b96ad84b9b5d43b4a1ae6b e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c\n", nil).
 				// here it's testing which of the configured main branches have an upstream
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master", nil).       // this one does
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "main@{u}"}, "", errors.New("error")).                   // this one doesn't, so it checks origin instead
@@ -123,6 +131,7 @@ func TestGetCommits(t *testing.T) {
 					AuthorName:    "Jesse Duffield",
 					AuthorEmail:   "jessedduffield@gmail.com",
 					UnixTimestamp: 1640823749,
+					HasNote:       true,
 					Parents: []string{
 						"d8084cd558925eb7c9c3",
 					},
@@ -211,6 +220,7 @@ func TestGetCommits(t *testing.T) {
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil).
 				// here it's testing which of the configured main branches exist; neither does
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "", errors.New("error")).
 				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/master"}, "", errors.New("error")).
@@ -248,6 +258,7 @@ func TestGetCommits(t *testing.T) {
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
 				// here it's actually getting all the commits in a formatted form, one per line
 				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil).
 				// here it's testing which of the configured main branches exist
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "master@{u}"}, "refs/remotes/origin/master", nil).
 				ExpectGitArgs([]string{"rev-parse", "--symbolic-full-name", "main@{u}"}, "", errors.New("error")).
@@ -276,6 +287,60 @@ func TestGetCommits(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			testName:       "should load signature status if enabled",
+			logOrder:       "topo-order",
+			rebaseMode:     enums.REBASE_MODE_NONE,
+			opts:           GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
+			showSignatures: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "", errors.New("error")).
+				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%G?%x00%GS%x00%s", "--abbrev=40", "--no-show-signature", "--"}, commitsWithSignaturesOutput, nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Hash:            "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:            "better typing for rebase mode",
+					Status:          models.StatusPushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					ExtraInfo:       "(HEAD -> better-tests)",
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640826609,
+					SignatureStatus: models.SignatureStatusNone,
+					Parents:         []string{"b21997d6b4cbdf84b149"},
+				},
+				{
+					Hash:            "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:            "fix logging",
+					Status:          models.StatusPushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640824515,
+					SignatureStatus: models.SignatureStatusGood,
+					Signer:          "Jesse Duffield <jessedduffield@gmail.com>",
+					Parents:         []string{"e94e8fc5b6fab4cb755f"},
+				},
+				{
+					Hash:            "e94e8fc5b6fab4cb755f29f1bdb3ee5e001df35c",
+					Name:            "refactor",
+					Status:          models.StatusPushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640823749,
+					SignatureStatus: models.SignatureStatusBad,
+					Signer:          "Jesse Duffield <jessedduffield@gmail.com>",
+					Parents:         []string{"d8084cd558925eb7c9c3"},
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			testName:   "should not specify order if `log.order` is `default`",
 			logOrder:   "default",
@@ -283,7 +348,8 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
-				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
@@ -295,7 +361,8 @@ func TestGetCommits(t *testing.T) {
 			opts:       GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", FilterPath: "src"},
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
-				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--follow", "--no-show-signature", "--", "src"}, "", nil),
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--follow", "--no-show-signature", "--", "src"}, "", nil).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "list"}, "", nil),
 
 			expectedCommits: []*models.Commit{},
 			expectedError:   nil,
@@ -320,9 +387,11 @@ func TestGetCommits(t *testing.T) {
 				walkFiles: func(root string, fn filepath.WalkFunc) error {
 					return nil
 				},
+				GitCommon: buildGitCommon(commonDeps{common: common}),
 			}
 
 			common.UserConfig().Git.MainBranches = scenario.mainBranches
+			common.UserConfig().Git.Log.ShowSignatures = scenario.showSignatures
 			opts := scenario.opts
 			opts.MainBranches = NewMainBranches(common, cmd)
 			commits, err := builder.GetCommits(opts)
@@ -558,3 +627,45 @@ func TestCommitLoader_setCommitMergedStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestCommitLoader_getRebasingCommits(t *testing.T) {
+	todoFileContent := `label onto
+pick 1234 first
+exec make test
+label feature
+reset onto
+pick 9abc side
+label side
+reset feature
+pick 5678 second
+exec make test
+merge -C abcd feature # Merge branch 'feature'
+update-ref refs/heads/other
+`
+
+	builder := &CommitLoader{
+		Common: utils.NewDummyCommon(),
+		readFile: func(filename string) ([]byte, error) {
+			if strings.HasSuffix(filename, "git-rebase-todo") {
+				return []byte(todoFileContent), nil
+			}
+			return nil, errors.New("file not found")
+		},
+		GitCommon: buildGitCommon(commonDeps{}),
+	}
+
+	commits := builder.getRebasingCommits(enums.REBASE_MODE_INTERACTIVE)
+
+	assert.Equal(t, []*models.Commit{
+		{Name: "refs/heads/other", Status: models.StatusRebasing, Action: todo.UpdateRef},
+		{Hash: "abcd", Name: "Merge branch 'feature'", Status: models.StatusRebasing, Action: todo.Merge},
+		{Name: "make test", Status: models.StatusRebasing, Action: todo.Exec, TodoOccurrence: 1},
+		{Hash: "5678", Name: "second", Status: models.StatusRebasing, Action: todo.Pick},
+		{Name: "feature", Status: models.StatusRebasing, Action: todo.Reset},
+		{Name: "side", Status: models.StatusRebasing, Action: todo.Label},
+		{Hash: "9abc", Name: "side", Status: models.StatusRebasing, Action: todo.Pick},
+		{Name: "feature", Status: models.StatusRebasing, Action: todo.Label},
+		{Name: "make test", Status: models.StatusRebasing, Action: todo.Exec},
+		{Hash: "1234", Name: "first", Status: models.StatusRebasing, Action: todo.Pick},
+	}, commits)
+}
@@ -234,6 +234,8 @@ func TestCommitShowCmdObj(t *testing.T) {
 		similarityThreshold int
 		ignoreWhitespace    bool
 		extDiffCmd          string
+		showSignatures      bool
+		notesRef            string
 		expected            []string
 	}
 
@@ -292,12 +294,34 @@ func TestCommitShowCmdObj(t *testing.T) {
 			extDiffCmd:          "difft --color=always",
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.external=difft --color=always", "-c", "diff.noprefix=false", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--find-renames=50%"},
 		},
+		{
+			testName:            "Show diff with signature",
+			filterPath:          "",
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			extDiffCmd:          "",
+			showSignatures:      true,
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "--show-signature", "-p", "1234567890", "--find-renames=50%"},
+		},
+		{
+			testName:            "Show notes from configured notes ref",
+			filterPath:          "",
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			extDiffCmd:          "",
+			notesRef:            "ci",
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "--notes=refs/notes/ci", "-p", "1234567890", "--find-renames=50%"},
+		},
 	}
 
 	for _, s := range scenarios {
 		t.Run(s.testName, func(t *testing.T) {
 			userConfig := config.GetDefaultConfig()
 			userConfig.Git.Paging.ExternalDiffCommand = s.extDiffCmd
+			userConfig.Git.Log.ShowSignatures = s.showSignatures
+			userConfig.Git.NotesRef = s.notesRef
 			appState := &config.AppState{}
 			appState.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
 			appState.DiffContextSize = s.contextSize
@@ -393,6 +417,29 @@ func TestGetCommitMessageFromHistory(t *testing.T) {
 	}
 }
 
+func TestGetCommitsOnelineBetween(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "--oneline", "--no-decorate", "--reverse", "--no-merges", "refs/tags/v1.0..HEAD"}, "abc1234 one\ndef5678 two\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	commits, err := instance.GetCommitsOnelineBetween("refs/tags/v1.0", "HEAD")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc1234 one\ndef5678 two\n", commits)
+	runner.CheckForMissingCalls()
+}
+
+func TestGetCommitMessagesBetween(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-c", "log.showsignature=false", "log", "--format=%B%x00", "--reverse", "--no-merges", "origin/main..feature"},
+			"first\n\nbody\n\n\x00\nsecond\n\n\x00\n", nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	messages, err := instance.GetCommitMessagesBetween("origin/main", "feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first\n\nbody", "second"}, messages)
+	runner.CheckForMissingCalls()
+}
+
 func TestAddCoAuthorToMessage(t *testing.T) {
 	scenarios := []struct {
 		name           string
@@ -108,6 +108,26 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 	return '#'
 }
 
+// Returns the notes ref configured with core.notesRef, or an empty string if
+// there is none
+func (self *ConfigCommands) GetCoreNotesRef() string {
+	return self.gitConfig.Get("core.notesRef")
+}
+
+func (self *ConfigCommands) GetCoreSshCommand() string {
+	return self.gitConfig.Get("core.sshCommand")
+}
+
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
+
+// Returns whether rerere.enabled is set to true, and whether it's set at all
+// (if it isn't, git enables rerere if the repo has an rr-cache directory)
+func (self *ConfigCommands) GetRerereEnabled() (bool, bool) {
+	if self.gitConfig.Get("rerere.enabled") == "" {
+		return false, false
+	}
+
+	return self.gitConfig.GetBool("rerere.enabled"), true
+}
@@ -185,3 +185,51 @@ func buildFlowCommands(deps commonDeps) *FlowCommands {
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildBlameCommands(deps commonDeps) *BlameCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewBlameCommands(gitCommon)
+}
+
+func buildNotesCommands(deps commonDeps) *NotesCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewNotesCommands(gitCommon)
+}
+
+func buildCommitFileLoader(deps commonDeps) *CommitFileLoader {
+	gitCommon := buildGitCommon(deps)
+
+	return NewCommitFileLoader(gitCommon)
+}
+
+func buildLfsCommands(deps commonDeps) *LfsCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewLfsCommands(gitCommon)
+}
+
+func buildSparseCheckoutCommands(deps commonDeps) *SparseCheckoutCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewSparseCheckoutCommands(gitCommon)
+}
+
+func buildRerereCommands(deps commonDeps) *RerereCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRerereCommands(gitCommon)
+}
+
+func buildDiffCommands(deps commonDeps) *DiffCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewDiffCommands(gitCommon)
+}
+
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
@@ -2,8 +2,15 @@ package git_commands
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type DiffCommands struct {
@@ -103,3 +110,104 @@ func (self *DiffCommands) DiffIndexCmdObj(diffArgs ...string) oscommands.ICmdObj
 			Arg(diffArgs...).ToArgv(),
 	)
 }
+
+// RangeDiff compares two versions of a series of commits, e.g. a branch before
+// and after rebasing it. oldRange and newRange can be ranges (like
+// "main..topic@{1}" and "main..topic") or single refs. If both are single
+// refs, the merge base of the two is used as the base of both ranges; if only
+// one of them is, it gets the same base as the other one. A symmetric range
+// (like "topic@{1}...topic") specifies both versions by itself, so it can only
+// be given as one of the two, with the other one empty.
+func (self *DiffCommands) RangeDiff(oldRange string, newRange string) ([]*models.RangeDiffPair, error) {
+	args, ok := rangeDiffArgs(oldRange, newRange)
+	if !ok {
+		return nil, errors.New(self.Tr.RangeDiffSymmetricRangeMustBeAlone)
+	}
+
+	cmdArgs := NewGitCmd("range-diff").
+		Arg(fmt.Sprintf("--color=%s", self.UserConfig().Git.Paging.ColorArg)).
+		Arg(args...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRangeDiff(output), nil
+}
+
+// Returns false if a symmetric range is combined with another ref or range
+func rangeDiffArgs(oldRange string, newRange string) ([]string, bool) {
+	if strings.Contains(oldRange, "...") || strings.Contains(newRange, "...") {
+		args := lo.Compact([]string{oldRange, newRange})
+		return args, len(args) == 1
+	}
+
+	oldBase, _, oldIsRange := strings.Cut(oldRange, "..")
+	newBase, _, newIsRange := strings.Cut(newRange, "..")
+
+	switch {
+	case oldIsRange && newIsRange:
+		return []string{oldRange, newRange}, true
+	case oldIsRange:
+		return []string{oldRange, oldBase + ".." + newRange}, true
+	case newIsRange:
+		return []string{newBase + ".." + oldRange, newRange}, true
+	default:
+		return []string{oldRange + "..." + newRange}, true
+	}
+}
+
+// Matches the header line of each pair, e.g. "1:  8d55ec6 ! 1:  fb8a49a extend f"
+var rangeDiffPairRegexp = regexp.MustCompile(`^\s*(\d+|-+):\s+([0-9a-f]+|-+) ([=!<>])\s+(\d+|-+):\s+([0-9a-f]+|-+) (.*)$`)
+
+func parseRangeDiff(output string) []*models.RangeDiffPair {
+	pairs := []*models.RangeDiffPair{}
+	var interdiffLines []string
+
+	finishPair := func() {
+		if len(pairs) > 0 && len(interdiffLines) > 0 {
+			pairs[len(pairs)-1].Interdiff = strings.Join(interdiffLines, "\n")
+		}
+		interdiffLines = nil
+	}
+
+	for _, line := range utils.SplitLines(output) {
+		match := rangeDiffPairRegexp.FindStringSubmatch(utils.Decolorise(line))
+		if match == nil {
+			// the interdiff of a changed pair is indented by four spaces
+			interdiffLines = append(interdiffLines, strings.TrimPrefix(line, "    "))
+			continue
+		}
+
+		finishPair()
+
+		pair := &models.RangeDiffPair{Summary: match[6]}
+		if index, err := strconv.Atoi(match[1]); err == nil {
+			pair.OldIndex = index
+			pair.OldHash = match[2]
+		}
+		if index, err := strconv.Atoi(match[4]); err == nil {
+			pair.NewIndex = index
+			pair.NewHash = match[5]
+		}
+
+		switch match[3] {
+		case "=":
+			pair.Status = models.RangeDiffUnchanged
+		case "!":
+			pair.Status = models.RangeDiffChanged
+		case "<":
+			pair.Status = models.RangeDiffRemoved
+		case ">":
+			pair.Status = models.RangeDiffAdded
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	finishPair()
+
+	return pairs
+}
@@ -0,0 +1,146 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRangeDiff(t *testing.T) {
+	output := `1:  8d55ec6 ! 1:  fb8a49a extend f
+    @@ f
+      19
+      20
+     +21
+    ++22
+2:  e8886fc = 2:  40adde6 add g
+3:  1234567 < -:  ------- remove me
+-:  ------- > 3:  89abcde add me
+`
+
+	expectedPairs := []*models.RangeDiffPair{
+		{
+			OldIndex:  1,
+			OldHash:   "8d55ec6",
+			NewIndex:  1,
+			NewHash:   "fb8a49a",
+			Status:    models.RangeDiffChanged,
+			Summary:   "extend f",
+			Interdiff: "@@ f\n  19\n  20\n +21\n++22",
+		},
+		{
+			OldIndex: 2,
+			OldHash:  "e8886fc",
+			NewIndex: 2,
+			NewHash:  "40adde6",
+			Status:   models.RangeDiffUnchanged,
+			Summary:  "add g",
+		},
+		{
+			OldIndex: 3,
+			OldHash:  "1234567",
+			Status:   models.RangeDiffRemoved,
+			Summary:  "remove me",
+		},
+		{
+			NewIndex: 3,
+			NewHash:  "89abcde",
+			Status:   models.RangeDiffAdded,
+			Summary:  "add me",
+		},
+	}
+
+	type scenario struct {
+		testName     string
+		oldRange     string
+		newRange     string
+		expectedArgs []string
+		expectedErr  string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "Two refs",
+			oldRange:     "topic@{1}",
+			newRange:     "topic",
+			expectedArgs: []string{"range-diff", "--color=always", "topic@{1}...topic"},
+		},
+		{
+			testName:     "Two ranges",
+			oldRange:     "main..topic@{1}",
+			newRange:     "main..topic",
+			expectedArgs: []string{"range-diff", "--color=always", "main..topic@{1}", "main..topic"},
+		},
+		{
+			testName:     "Old range and new ref",
+			oldRange:     "main..topic@{1}",
+			newRange:     "topic",
+			expectedArgs: []string{"range-diff", "--color=always", "main..topic@{1}", "main..topic"},
+		},
+		{
+			testName:     "Old ref and new range",
+			oldRange:     "topic@{1}",
+			newRange:     "origin/main..topic",
+			expectedArgs: []string{"range-diff", "--color=always", "origin/main..topic@{1}", "origin/main..topic"},
+		},
+		{
+			testName:     "Symmetric range as old version",
+			oldRange:     "topic@{1}...topic",
+			newRange:     "",
+			expectedArgs: []string{"range-diff", "--color=always", "topic@{1}...topic"},
+		},
+		{
+			testName:     "Symmetric range as new version",
+			oldRange:     "",
+			newRange:     "topic@{1}...topic",
+			expectedArgs: []string{"range-diff", "--color=always", "topic@{1}...topic"},
+		},
+		{
+			testName:    "Symmetric range and ref",
+			oldRange:    "topic@{1}...topic",
+			newRange:    "main",
+			expectedErr: "A symmetric range (e.g. topic@{1}...topic) already contains both versions, so it can't be combined with another ref or range. Leave the other version empty.",
+		},
+		{
+			testName:    "Range and symmetric range",
+			oldRange:    "main..topic@{1}",
+			newRange:    "main...topic",
+			expectedErr: "A symmetric range (e.g. topic@{1}...topic) already contains both versions, so it can't be combined with another ref or range. Leave the other version empty.",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t)
+			if s.expectedArgs != nil {
+				runner.ExpectGitArgs(s.expectedArgs, output, nil)
+			}
+			instance := buildDiffCommands(commonDeps{runner: runner})
+
+			pairs, err := instance.RangeDiff(s.oldRange, s.newRange)
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, expectedPairs, pairs)
+			}
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestDiffParseRangeDiffWithColors(t *testing.T) {
+	output := "\x1b[31m1:  8d55ec6 \x1b[m\x1b[33m!\x1b[m\x1b[32m 1:  fb8a49a\x1b[m\x1b[33m extend f\x1b[m\n" +
+		"    \x1b[7m\x1b[36m@@\x1b[m \x1b[mf\x1b[m\n" +
+		"\x1b[33m2:  e8886fc = 2:  40adde6 add g\x1b[m\n"
+
+	pairs := parseRangeDiff(output)
+	assert.Len(t, pairs, 2)
+	assert.Equal(t, "extend f", pairs[0].Summary)
+	assert.Equal(t, models.RangeDiffChanged, pairs[0].Status)
+	assert.Equal(t, "\x1b[7m\x1b[36m@@\x1b[m \x1b[mf\x1b[m", pairs[0].Interdiff)
+	assert.Equal(t, "add g", pairs[1].Summary)
+	assert.Equal(t, "", pairs[1].Interdiff)
+}
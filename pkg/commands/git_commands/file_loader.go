@@ -8,6 +8,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type FileLoaderConfig interface {
@@ -102,9 +103,43 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 	}
 
+	if usesLfs(self.Fs, self.repoPaths) {
+		self.setLfsFields(files)
+	}
+
+	if lo.SomeBy(files, func(file *models.File) bool { return file.HasMergeConflicts }) &&
+		rerereEnabled(self.Fs, self.repoPaths, self.GitCommon.config) {
+		self.setRerereFields(files)
+	}
+
 	return files
 }
 
+func (self *FileLoader) setRerereFields(files []*models.File) {
+	remainingPaths := rerereRemainingPaths(self.cmd)
+	if remainingPaths == nil {
+		return
+	}
+
+	for _, file := range files {
+		file.ResolvedByRerere = file.HasMergeConflicts && !remainingPaths[file.Name]
+	}
+}
+
+func (self *FileLoader) setLfsFields(files []*models.File) {
+	paths := lo.Map(files, func(file *models.File, _ int) string { return file.Name })
+	trackedPaths := lfsTrackedPaths(self.cmd, self.Log, paths, "")
+	if len(trackedPaths) == 0 {
+		return
+	}
+
+	locksByPath := lo.KeyBy(lfsLocalLocks(self.cmd), func(lock *models.LfsLock) string { return lock.Path })
+	for _, file := range files {
+		file.IsLfs = trackedPaths[file.Name]
+		file.LfsLock = locksByPath[file.Name]
+	}
+}
+
 type FileDiff struct {
 	LinesAdded   int
 	LinesDeleted int
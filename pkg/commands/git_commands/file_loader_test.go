@@ -3,9 +3,11 @@ package git_commands
 import (
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -226,3 +228,61 @@ type FakeFileLoaderConfig struct {
 func (self *FakeFileLoaderConfig) GetShowUntrackedFiles() string {
 	return self.showUntrackedFiles
 }
+
+func TestFileGetStatusFilesWithLfs(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+			" M image.psd\x00 M video.mp4\x00 M file.txt", nil).
+		ExpectGitArgs([]string{"check-attr", "--stdin", "-z", "filter"},
+			"image.psd\x00filter\x00lfs\x00video.mp4\x00filter\x00lfs\x00file.txt\x00filter\x00unspecified\x00", nil).
+		ExpectGitArgs([]string{"lfs", "locks", "--local", "--json"},
+			`[{"id":"1","path":"image.psd","owner":{"name":"Jane"}}]`, nil)
+
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, ".gitattributes", []byte("*.psd filter=lfs diff=lfs merge=lfs -text\n"), 0o644))
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{RenameSimilarityThreshold: 50}, fs: fs, repoPaths: MockRepoPaths("")}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{})
+	runner.CheckForMissingCalls()
+
+	assert.Len(t, files, 3)
+	assert.True(t, files[0].IsLfs)
+	assert.Equal(t, &models.LfsLock{ID: "1", Path: "image.psd", Owner: "Jane"}, files[0].LfsLock)
+	assert.True(t, files[1].IsLfs)
+	assert.Nil(t, files[1].LfsLock)
+	assert.False(t, files[2].IsLfs)
+}
+
+func TestFileGetStatusFilesWithRerere(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+			"UU resolved.txt\x00UU unresolved.txt\x00M  other.txt", nil).
+		ExpectGitArgs([]string{"-c", "core.quotePath=false", "rerere", "remaining"},
+			"unresolved.txt\n", nil)
+
+	loader := &FileLoader{
+		GitCommon: buildGitCommon(commonDeps{
+			appState:  &config.AppState{RenameSimilarityThreshold: 50},
+			fs:        afero.NewMemMapFs(),
+			repoPaths: MockRepoPaths(""),
+			gitConfig: git_config.NewFakeGitConfig(map[string]string{"rerere.enabled": "true"}),
+		}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	files := loader.GetStatusFiles(GetStatusFileOptions{})
+	runner.CheckForMissingCalls()
+
+	assert.Len(t, files, 3)
+	assert.True(t, files[0].ResolvedByRerere)
+	assert.False(t, files[1].ResolvedByRerere)
+	assert.False(t, files[2].ResolvedByRerere)
+}
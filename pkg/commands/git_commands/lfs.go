@@ -0,0 +1,210 @@
+package git_commands
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+const lfsPointerVersionLine = "version https://git-lfs.github.com/spec/v1"
+
+type LfsCommands struct {
+	*GitCommon
+}
+
+func NewLfsCommands(gitCommon *GitCommon) *LfsCommands {
+	return &LfsCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+func (self *LfsCommands) Lock(path string) error {
+	cmdArgs := NewGitCmd("lfs").Arg("lock", path).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *LfsCommands) Unlock(path string, force bool) error {
+	cmdArgs := NewGitCmd("lfs").Arg("unlock").
+		ArgIf(force, "--force").
+		Arg(path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Returns the locks that we hold ourselves, as far as git-lfs knows without
+// asking the server
+func (self *LfsCommands) LocalLocks() []*models.LfsLock {
+	return lfsLocalLocks(self.cmd)
+}
+
+// Returns whether any .gitattributes file that applies to the whole repo sets
+// the lfs filter. This is a cheap check that lets us avoid asking git about
+// attributes in the (much more common) case of a repo that doesn't use LFS.
+// It doesn't look at .gitattributes files in subdirectories, which are rarely
+// used for LFS.
+func usesLfs(fs afero.Fs, repoPaths *RepoPaths) bool {
+	attributesFiles := []string{
+		filepath.Join(repoPaths.WorktreePath(), ".gitattributes"),
+		filepath.Join(repoPaths.RepoGitDirPath(), "info", "attributes"),
+	}
+
+	return lo.SomeBy(attributesFiles, func(path string) bool {
+		content, err := afero.ReadFile(fs, path)
+		return err == nil && strings.Contains(string(content), "filter=lfs")
+	})
+}
+
+// Returns the subset of the given paths whose filter attribute is 'lfs'. The
+// paths are passed on stdin rather than as arguments, because there can be
+// too many of them for the command line in large repos. If source is not
+// empty, the attributes are read from the .gitattributes files in that tree
+// rather than the ones in the working tree.
+func lfsTrackedPaths(cmd oscommands.ICmdObjBuilder, log *logrus.Entry, paths []string, source string) map[string]bool {
+	result := map[string]bool{}
+	if len(paths) == 0 {
+		return result
+	}
+
+	cmdArgs := NewGitCmd("check-attr").
+		Arg("--stdin", "-z").
+		ArgIf(source != "", "--source="+source).
+		Arg("filter").
+		ToArgv()
+	cmdObj := cmd.New(cmdArgs).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(strings.Join(paths, "\x00") + "\x00")
+	output, err := cmdObj.RunWithOutput()
+	if err != nil {
+		log.Warnf("Could not get the LFS attributes of files: %v", err)
+		return result
+	}
+
+	return parseLfsTrackedPaths(output)
+}
+
+// Parses the output of `git check-attr -z filter`, which consists of
+// NUL-separated triples of path, attribute name, and attribute value
+func parseLfsTrackedPaths(output string) map[string]bool {
+	result := map[string]bool{}
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if fields[i+2] == "lfs" {
+			result[fields[i]] = true
+		}
+	}
+	return result
+}
+
+// Returns the locks that we know about without talking to the LFS server, i.e.
+// the ones that we've taken ourselves. Returns nil if git-lfs isn't
+// installed.
+func lfsLocalLocks(cmd oscommands.ICmdObjBuilder) []*models.LfsLock {
+	cmdArgs := NewGitCmd("lfs").Arg("locks", "--local", "--json").ToArgv()
+	output, err := cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	return parseLfsLocks(output)
+}
+
+type lfsLockJSON struct {
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Owner struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+}
+
+func parseLfsLocks(output string) []*models.LfsLock {
+	var rawLocks []lfsLockJSON
+	if err := json.Unmarshal([]byte(output), &rawLocks); err != nil {
+		return nil
+	}
+
+	return lo.Map(rawLocks, func(rawLock lfsLockJSON, _ int) *models.LfsLock {
+		return &models.LfsLock{ID: rawLock.ID, Path: rawLock.Path, Owner: rawLock.Owner.Name}
+	})
+}
+
+// Takes the plain diff of a file tracked by LFS and returns the old and new
+// pointers. Either of them is nil if the file was added or deleted. Returns
+// false if the diff is not a diff between pointer files, e.g. because the
+// file was committed before it was tracked by LFS.
+func ParseLfsPointerDiff(diff string) (*models.LfsPointer, *models.LfsPointer, bool) {
+	oldLines := []string{}
+	newLines := []string{}
+	inHunk := false
+	for _, line := range utils.SplitLines(diff) {
+		if strings.HasPrefix(line, "@@") {
+			inHunk = true
+			continue
+		}
+		if !inHunk || line == "" {
+			continue
+		}
+
+		content := line[1:]
+		switch line[0] {
+		case ' ':
+			oldLines = append(oldLines, content)
+			newLines = append(newLines, content)
+		case '-':
+			oldLines = append(oldLines, content)
+		case '+':
+			newLines = append(newLines, content)
+		}
+	}
+
+	oldPointer, oldOk := parseLfsPointer(oldLines)
+	newPointer, newOk := parseLfsPointer(newLines)
+	if !oldOk || !newOk || (oldPointer == nil && newPointer == nil) {
+		return nil, nil, false
+	}
+
+	return oldPointer, newPointer, true
+}
+
+// Returns a nil pointer (and true) if there are no lines at all, because that
+// means the file doesn't exist on that side of the diff
+func parseLfsPointer(lines []string) (*models.LfsPointer, bool) {
+	if len(lines) == 0 {
+		return nil, true
+	}
+	if lines[0] != lfsPointerVersionLine {
+		return nil, false
+	}
+
+	pointer := &models.LfsPointer{}
+	for _, line := range lines[1:] {
+		key, value, found := strings.Cut(line, " ")
+		if !found {
+			return nil, false
+		}
+		switch key {
+		case "oid":
+			pointer.Oid = value
+		case "size":
+			size, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, false
+			}
+			pointer.Size = size
+		}
+	}
+
+	if pointer.Oid == "" {
+		return nil, false
+	}
+
+	return pointer, true
+}
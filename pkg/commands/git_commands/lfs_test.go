@@ -0,0 +1,148 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLfsLock(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"lfs", "lock", "image.psd"}, "", nil)
+	instance := buildLfsCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Lock("image.psd"))
+	runner.CheckForMissingCalls()
+}
+
+func TestLfsUnlock(t *testing.T) {
+	type scenario struct {
+		testName     string
+		force        bool
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "unlock",
+			force:        false,
+			expectedArgs: []string{"lfs", "unlock", "image.psd"},
+		},
+		{
+			testName:     "force unlock",
+			force:        true,
+			expectedArgs: []string{"lfs", "unlock", "--force", "image.psd"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildLfsCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Unlock("image.psd", s.force))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestParseLfsLocks(t *testing.T) {
+	output := `[{"id":"1","path":"image.psd","owner":{"name":"Jane"},"locked_at":"2024-05-17T15:49:06+00:00"},{"id":"2","path":"video.mp4","owner":{"name":"John"},"locked_at":"2024-05-18T10:00:00+00:00"}]`
+
+	assert.Equal(t, []*models.LfsLock{
+		{ID: "1", Path: "image.psd", Owner: "Jane"},
+		{ID: "2", Path: "video.mp4", Owner: "John"},
+	}, parseLfsLocks(output))
+
+	assert.Nil(t, parseLfsLocks("not json"))
+}
+
+func TestParseLfsPointerDiff(t *testing.T) {
+	type scenario struct {
+		testName    string
+		diff        string
+		expectedOld *models.LfsPointer
+		expectedNew *models.LfsPointer
+		expectedOk  bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "changed object",
+			diff: `diff --git a/image.psd b/image.psd
+index 7d3b5c1..1e4f7a2 100644
+--- a/image.psd
++++ b/image.psd
+@@ -1,3 +1,3 @@
+ version https://git-lfs.github.com/spec/v1
+-oid sha256:1111111111111111111111111111111111111111111111111111111111111111
+-size 1024
++oid sha256:2222222222222222222222222222222222222222222222222222222222222222
++size 2048000
+`,
+			expectedOld: &models.LfsPointer{Oid: "sha256:1111111111111111111111111111111111111111111111111111111111111111", Size: 1024},
+			expectedNew: &models.LfsPointer{Oid: "sha256:2222222222222222222222222222222222222222222222222222222222222222", Size: 2048000},
+			expectedOk:  true,
+		},
+		{
+			testName: "added object",
+			diff: `diff --git a/image.psd b/image.psd
+new file mode 100644
+index 0000000..1e4f7a2
+--- /dev/null
++++ b/image.psd
+@@ -0,0 +1,3 @@
++version https://git-lfs.github.com/spec/v1
++oid sha256:2222222222222222222222222222222222222222222222222222222222222222
++size 512
+`,
+			expectedOld: nil,
+			expectedNew: &models.LfsPointer{Oid: "sha256:2222222222222222222222222222222222222222222222222222222222222222", Size: 512},
+			expectedOk:  true,
+		},
+		{
+			testName: "deleted object",
+			diff: `diff --git a/image.psd b/image.psd
+deleted file mode 100644
+index 1e4f7a2..0000000
+--- a/image.psd
++++ /dev/null
+@@ -1,3 +0,0 @@
+-version https://git-lfs.github.com/spec/v1
+-oid sha256:2222222222222222222222222222222222222222222222222222222222222222
+-size 512
+`,
+			expectedOld: &models.LfsPointer{Oid: "sha256:2222222222222222222222222222222222222222222222222222222222222222", Size: 512},
+			expectedNew: nil,
+			expectedOk:  true,
+		},
+		{
+			testName: "regular text diff",
+			diff: `diff --git a/file.txt b/file.txt
+index 7d3b5c1..1e4f7a2 100644
+--- a/file.txt
++++ b/file.txt
+@@ -1 +1 @@
+-hello
++world
+`,
+			expectedOk: false,
+		},
+		{
+			testName:   "binary diff",
+			diff:       "diff --git a/image.psd b/image.psd\nindex 7d3b5c1..1e4f7a2 100644\nBinary files a/image.psd and b/image.psd differ\n",
+			expectedOk: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			oldPointer, newPointer, ok := ParseLfsPointerDiff(s.diff)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expectedOld, oldPointer)
+			assert.Equal(t, s.expectedNew, newPointer)
+		})
+	}
+}
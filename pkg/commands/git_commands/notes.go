@@ -0,0 +1,81 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+)
+
+// NotesCommands operate on notes in the notes ref returned by getNotesRef
+type NotesCommands struct {
+	*GitCommon
+}
+
+func NewNotesCommands(gitCommon *GitCommon) *NotesCommands {
+	return &NotesCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns the full name of the notes ref that we show, edit and sync notes in:
+// the one from our config if set, otherwise git's default. We always pass it
+// to git explicitly so that listing notes and syncing them can't disagree
+// about which ref to use.
+func getNotesRef(userConfig *config.UserConfig, gitConfig *ConfigCommands) string {
+	ref := userConfig.Git.NotesRef
+	if ref == "" {
+		ref = gitConfig.GetCoreNotesRef()
+	}
+	if ref == "" {
+		return "refs/notes/commits"
+	}
+
+	// Like git, we accept short names, e.g. "ci" for refs/notes/ci
+	switch {
+	case strings.HasPrefix(ref, "refs/notes/"):
+		return ref
+	case strings.HasPrefix(ref, "notes/"):
+		return "refs/" + ref
+	default:
+		return "refs/notes/" + ref
+	}
+}
+
+func (self *NotesCommands) newNotesCmd() *GitCommandBuilder {
+	return NewGitCmd("notes").Arg("--ref=" + getNotesRef(self.UserConfig(), self.config))
+}
+
+// Returns the note attached to the given commit, or an empty string if there
+// is none
+func (self *NotesCommands) Get(hash string) string {
+	cmdArgs := self.newNotesCmd().Arg("show", hash).ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSuffix(output, "\n")
+}
+
+// Sets the note of the given commit, replacing any existing note
+func (self *NotesCommands) Set(hash string, message string) error {
+	cmdArgs := self.newNotesCmd().Arg("add", "--force", "-m", message, hash).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *NotesCommands) Remove(hash string) error {
+	cmdArgs := self.newNotesCmd().Arg("remove", "--ignore-missing", hash).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Opens the note of the given commit in the user's editor (via git's own
+// editor handling), creating it if it doesn't exist yet
+func (self *NotesCommands) EditCmdObj(hash string) oscommands.ICmdObj {
+	cmdArgs := self.newNotesCmd().Arg("edit", hash).ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
@@ -0,0 +1,113 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotesGet(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "commit with note",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "show", "abc123"}, "line 1\nline 2\n", nil),
+			expected: "line 1\nline 2",
+		},
+		{
+			testName: "commit without note",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "show", "abc123"}, "", errors.New("error: no note found for object abc123.")),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildNotesCommands(commonDeps{runner: s.runner})
+
+			assert.Equal(t, s.expected, instance.Get("abc123"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestNotesSet(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "add", "--force", "-m", "my note", "abc123"}, "", nil)
+	instance := buildNotesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Set("abc123", "my note"))
+	runner.CheckForMissingCalls()
+}
+
+func TestNotesRemove(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"notes", "--ref=refs/notes/commits", "remove", "--ignore-missing", "abc123"}, "", nil)
+	instance := buildNotesCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Remove("abc123"))
+	runner.CheckForMissingCalls()
+}
+
+func TestNotesEditCmdObj(t *testing.T) {
+	instance := buildNotesCommands(commonDeps{})
+
+	assert.Equal(t, []string{"git", "notes", "--ref=refs/notes/commits", "edit", "abc123"}, instance.EditCmdObj("abc123").Args())
+}
+
+func TestGetNotesRef(t *testing.T) {
+	scenarios := []struct {
+		testName       string
+		configNotesRef string
+		coreNotesRef   string
+		expectedRef    string
+	}{
+		{
+			testName:    "default",
+			expectedRef: "refs/notes/commits",
+		},
+		{
+			testName:     "from core.notesRef",
+			coreNotesRef: "refs/notes/review",
+			expectedRef:  "refs/notes/review",
+		},
+		{
+			testName:       "config takes precedence over core.notesRef",
+			configNotesRef: "refs/notes/ci",
+			coreNotesRef:   "refs/notes/review",
+			expectedRef:    "refs/notes/ci",
+		},
+		{
+			testName:       "short name",
+			configNotesRef: "ci",
+			expectedRef:    "refs/notes/ci",
+		},
+		{
+			testName:     "name relative to refs",
+			coreNotesRef: "notes/ci",
+			expectedRef:  "refs/notes/ci",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.NotesRef = s.configNotesRef
+			gitConfig := git_config.NewFakeGitConfig(map[string]string{"core.notesRef": s.coreNotesRef})
+			instance := buildNotesCommands(commonDeps{userConfig: userConfig, gitConfig: gitConfig})
+
+			assert.Equal(t, s.expectedRef, getNotesRef(instance.UserConfig(), instance.config))
+		})
+	}
+}
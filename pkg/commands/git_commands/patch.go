@@ -2,6 +2,7 @@ package git_commands
 
 import (
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/go-errors/errors"
@@ -9,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/samber/lo"
 	"github.com/stefanhaller/git-todo-parser/todo"
 )
 
@@ -45,6 +47,8 @@ type ApplyPatchOpts struct {
 	Cached   bool
 	Index    bool
 	Reverse  bool
+	// Only check whether the patch applies, without applying it
+	Check bool
 }
 
 func (self *PatchCommands) ApplyCustomPatch(reverse bool, turnAddedFilesIntoDiffAgainstEmptyFile bool) error {
@@ -72,6 +76,7 @@ func (self *PatchCommands) applyPatchFile(filepath string, opts ApplyPatchOpts)
 		ArgIf(opts.Cached, "--cached").
 		ArgIf(opts.Index, "--index").
 		ArgIf(opts.Reverse, "--reverse").
+		ArgIf(opts.Check, "--check").
 		Arg(filepath).
 		ToArgv()
 
@@ -87,6 +92,113 @@ func (self *PatchCommands) SaveTemporaryPatch(patch string) (string, error) {
 	return filepath, nil
 }
 
+// StashPatch stores the changes of the given patch as a new stash entry,
+// without touching the working tree or the index. The patch must apply to the
+// index if staged is false, or to HEAD if staged is true; in the latter case
+// the changes are recorded as staged in the stash entry, so that applying the
+// stash with --index restores them to the index.
+//
+// It is up to the caller to remove the changes from the working tree
+// afterwards, and to make sure beforehand that this will work.
+func (self *PatchCommands) StashPatch(patch string, message string, staged bool) error {
+	patchFilepath, err := self.SaveTemporaryPatch(patch)
+	if err != nil {
+		return err
+	}
+
+	headHash, err := self.revParse("HEAD")
+	if err != nil {
+		return err
+	}
+
+	headTree, err := self.revParse("HEAD^{tree}")
+	if err != nil {
+		return err
+	}
+
+	// The stash entry's base commit must contain whatever the patch applies
+	// to, otherwise applying the stash later would also bring back unrelated
+	// staged changes. If the index differs from HEAD we therefore create an
+	// intermediate commit for it.
+	baseHash := headHash
+	baseTree := headTree
+	if !staged {
+		baseTree, err = self.writeTree(nil)
+		if err != nil {
+			return err
+		}
+
+		if baseTree != headTree {
+			baseHash, err = self.commitTree(baseTree, "index on "+message, headHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Build the stashed tree in a temporary index so that the real one stays
+	// untouched
+	indexEnvVars := []string{"GIT_INDEX_FILE=" + patchFilepath + ".index"}
+	defer func() { _ = self.os.Remove(patchFilepath + ".index") }()
+
+	if err := self.cmd.New(NewGitCmd("read-tree").Arg(baseTree).ToArgv()).
+		AddEnvVars(indexEnvVars...).Run(); err != nil {
+		return err
+	}
+
+	if err := self.cmd.New(NewGitCmd("apply").Arg("--cached", patchFilepath).ToArgv()).
+		AddEnvVars(indexEnvVars...).Run(); err != nil {
+		return err
+	}
+
+	stashedTree, err := self.writeTree(indexEnvVars)
+	if err != nil {
+		return err
+	}
+
+	indexTree := baseTree
+	if staged {
+		indexTree = stashedTree
+	}
+
+	indexHash, err := self.commitTree(indexTree, "index on "+message, baseHash)
+	if err != nil {
+		return err
+	}
+
+	stashHash, err := self.commitTree(stashedTree, message, baseHash, indexHash)
+	if err != nil {
+		return err
+	}
+
+	return self.stash.Store(stashHash, message)
+}
+
+func (self *PatchCommands) revParse(ref string) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").Arg(ref).ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+func (self *PatchCommands) writeTree(envVars []string) (string, error) {
+	cmdArgs := NewGitCmd("write-tree").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).AddEnvVars(envVars...).RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+func (self *PatchCommands) commitTree(tree string, message string, parents ...string) (string, error) {
+	cmdArgs := NewGitCmd("commit-tree").
+		Arg(tree).
+		Arg(lo.FlatMap(parents, func(parent string, _ int) []string { return []string{"-p", parent} })...).
+		Arg("-m", message).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
 // DeletePatchesFromCommit applies a patch in reverse for a commit
 func (self *PatchCommands) DeletePatchesFromCommit(commits []*models.Commit, commitIndex int) error {
 	if err := self.rebase.BeginInteractiveRebaseForCommit(commits, commitIndex, false); err != nil {
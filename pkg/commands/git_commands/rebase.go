@@ -137,6 +137,22 @@ func (self *RebaseCommands) MoveCommitsUp(commits []*models.Commit, startIdx int
 	}).Run()
 }
 
+// Starts an interactive rebase that runs the given command after each of the
+// commits in the given range
+func (self *RebaseCommands) ExecAfterCommits(commits []*models.Commit, startIdx int, endIdx int, command string) error {
+	baseHashOrRoot := getBaseHashOrRoot(commits, endIdx+1)
+
+	hashes := lo.Map(commits[startIdx:endIdx+1], func(commit *models.Commit, _ int) string {
+		return commit.Hash
+	})
+
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseHashOrRoot: baseHashOrRoot,
+		instruction:    daemon.NewInsertExecTodosInstruction(hashes, command),
+		overrideEditor: true,
+	}).Run()
+}
+
 func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, startIdx int, endIdx int, action todo.TodoCommand) error {
 	baseIndex := endIdx + 1
 	if action == todo.Squash || action == todo.Fixup {
@@ -325,6 +341,8 @@ func (self *RebaseCommands) MoveFixupCommitDown(commits []*models.Commit, target
 func todoFromCommit(commit *models.Commit) utils.Todo {
 	if commit.Action == todo.UpdateRef {
 		return utils.Todo{Ref: commit.Name}
+	} else if commit.Hash == "" {
+		return utils.Todo{Command: commit.Action, Text: commit.Name, Occurrence: commit.TodoOccurrence}
 	} else {
 		return utils.Todo{Hash: commit.Hash}
 	}
@@ -346,7 +364,8 @@ func (self *RebaseCommands) EditRebaseTodo(commits []*models.Commit, action todo
 	)
 }
 
-func (self *RebaseCommands) DeleteUpdateRefTodos(commits []*models.Commit) error {
+// Deletes todos that don't pick a commit, e.g. update-ref or exec todos
+func (self *RebaseCommands) DeleteNonCommitTodos(commits []*models.Commit) error {
 	todosToDelete := lo.Map(commits, func(commit *models.Commit, _ int) utils.Todo {
 		return todoFromCommit(commit)
 	})
@@ -363,6 +382,20 @@ func (self *RebaseCommands) DeleteUpdateRefTodos(commits []*models.Commit) error
 	return self.GitRebaseEditTodo(todosFileContent)
 }
 
+// Inserts an exec todo running the given command after each of the given todos
+func (self *RebaseCommands) InsertExecTodos(commits []*models.Commit, command string) error {
+	todosToFollow := lo.Map(commits, func(commit *models.Commit, _ int) utils.Todo {
+		return todoFromCommit(commit)
+	})
+
+	return utils.InsertExecTodos(
+		filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo"),
+		todosToFollow,
+		command,
+		self.config.GetCoreCommentChar(),
+	)
+}
+
 func (self *RebaseCommands) MoveTodosDown(commits []*models.Commit) error {
 	fileName := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo")
 	todosToMove := lo.Map(commits, func(commit *models.Commit, _ int) utils.Todo {
@@ -30,7 +30,8 @@ func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit
 		Config("log.showSignature=false").
 		Arg("-g").
 		Arg("--abbrev=40").
-		Arg("--format=%h%x00%ct%x00%gs%x00%p").
+		Arg("--date=unix").
+		Arg("--format=%h%x00%ct%x00%gd%x00%gs%x00%p").
 		ArgIf(filterAuthor != "", "--author="+filterAuthor).
 		ArgIf(filterPath != "", "--follow", "--", filterPath).
 		ToArgv()
@@ -64,29 +65,64 @@ func (self *ReflogCommitLoader) GetReflogCommits(lastReflogCommit *models.Commit
 	return commits, onlyObtainedNewReflogCommits, nil
 }
 
+// GetBranchReflogCommits returns all the entries of the reflog of the given
+// ref (e.g. a local or remote-tracking branch), most recent first
+func (self *ReflogCommitLoader) GetBranchReflogCommits(refName string) ([]*models.Commit, error) {
+	commits := make([]*models.Commit, 0)
+
+	cmdArgs := NewGitCmd("log").
+		Config("log.showSignature=false").
+		Arg("-g").
+		Arg("--abbrev=40").
+		Arg("--date=unix").
+		Arg("--format=%h%x00%ct%x00%gd%x00%gs%x00%p").
+		Arg(refName).
+		Arg("--").
+		ToArgv()
+
+	err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+		if commit, ok := self.parseLine(line); ok {
+			commits = append(commits, commit)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
 func (self *ReflogCommitLoader) sameReflogCommit(a *models.Commit, b *models.Commit) bool {
 	return a.Hash == b.Hash && a.UnixTimestamp == b.UnixTimestamp && a.Name == b.Name
 }
 
 func (self *ReflogCommitLoader) parseLine(line string) (*models.Commit, bool) {
-	fields := strings.SplitN(line, "\x00", 4)
-	if len(fields) <= 3 {
+	fields := strings.SplitN(line, "\x00", 5)
+	if len(fields) <= 4 {
 		return nil, false
 	}
 
 	unixTimestamp, _ := strconv.Atoi(fields[1])
 
-	parentHashes := fields[3]
+	// With --date=unix, the reflog selector looks like HEAD@{1643150483}
+	reflogTimestamp := 0
+	if _, selectorDate, found := strings.Cut(fields[2], "@{"); found {
+		reflogTimestamp, _ = strconv.Atoi(strings.TrimSuffix(selectorDate, "}"))
+	}
+
+	parentHashes := fields[4]
 	parents := []string{}
 	if len(parentHashes) > 0 {
 		parents = strings.Split(parentHashes, " ")
 	}
 
 	return &models.Commit{
-		Hash:          fields[0],
-		Name:          fields[2],
-		UnixTimestamp: int64(unixTimestamp),
-		Status:        models.StatusReflog,
-		Parents:       parents,
+		Hash:            fields[0],
+		Name:            fields[3],
+		UnixTimestamp:   int64(unixTimestamp),
+		ReflogTimestamp: int64(reflogTimestamp),
+		Status:          models.StatusReflog,
+		Parents:         parents,
 	}, true
 }
@@ -12,11 +12,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-var reflogOutput = strings.Replace(`c3c4b66b64c97ffeecde|1643150483|checkout: moving from A to B|51baa8c1
-c3c4b66b64c97ffeecde|1643150483|checkout: moving from B to A|51baa8c1
-c3c4b66b64c97ffeecde|1643150483|checkout: moving from A to B|51baa8c1
-c3c4b66b64c97ffeecde|1643150483|checkout: moving from master to A|51baa8c1
-f4ddf2f0d4be4ccc7efa|1643149435|checkout: moving from A to master|51baa8c1
+var reflogOutput = strings.Replace(`c3c4b66b64c97ffeecde|1643150483|HEAD@{1643150700}|checkout: moving from A to B|51baa8c1
+c3c4b66b64c97ffeecde|1643150483|HEAD@{1643150600}|checkout: moving from B to A|51baa8c1
+c3c4b66b64c97ffeecde|1643150483|HEAD@{1643150500}|checkout: moving from A to B|51baa8c1
+c3c4b66b64c97ffeecde|1643150483|HEAD@{1643150483}|checkout: moving from master to A|51baa8c1
+f4ddf2f0d4be4ccc7efa|1643149435|HEAD@{1643149435}|checkout: moving from A to master|51baa8c1
 `, "|", "\x00", -1)
 
 func TestGetReflogCommits(t *testing.T) {
@@ -35,7 +35,7 @@ func TestGetReflogCommits(t *testing.T) {
 		{
 			testName: "no reflog entries",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p"}, "", nil),
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p"}, "", nil),
 
 			lastReflogCommit:        nil,
 			expectedCommits:         []*models.Commit{},
@@ -45,44 +45,49 @@ func TestGetReflogCommits(t *testing.T) {
 		{
 			testName: "some reflog entries",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p"}, reflogOutput, nil),
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p"}, reflogOutput, nil),
 
 			lastReflogCommit: nil,
 			expectedCommits: []*models.Commit{
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from A to B",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from A to B",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150700,
+					Parents:         []string{"51baa8c1"},
 				},
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from B to A",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from B to A",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150600,
+					Parents:         []string{"51baa8c1"},
 				},
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from A to B",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from A to B",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150500,
+					Parents:         []string{"51baa8c1"},
 				},
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from master to A",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from master to A",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150483,
+					Parents:         []string{"51baa8c1"},
 				},
 				{
-					Hash:          "f4ddf2f0d4be4ccc7efa",
-					Name:          "checkout: moving from A to master",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643149435,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "f4ddf2f0d4be4ccc7efa",
+					Name:            "checkout: moving from A to master",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643149435,
+					ReflogTimestamp: 1643149435,
+					Parents:         []string{"51baa8c1"},
 				},
 			},
 			expectedOnlyObtainedNew: false,
@@ -91,7 +96,7 @@ func TestGetReflogCommits(t *testing.T) {
 		{
 			testName: "some reflog entries where last commit is given",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p"}, reflogOutput, nil),
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p"}, reflogOutput, nil),
 
 			lastReflogCommit: &models.Commit{
 				Hash:          "c3c4b66b64c97ffeecde",
@@ -102,11 +107,12 @@ func TestGetReflogCommits(t *testing.T) {
 			},
 			expectedCommits: []*models.Commit{
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from A to B",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from A to B",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150700,
+					Parents:         []string{"51baa8c1"},
 				},
 			},
 			expectedOnlyObtainedNew: true,
@@ -115,7 +121,7 @@ func TestGetReflogCommits(t *testing.T) {
 		{
 			testName: "when passing filterPath",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p", "--follow", "--", "path"}, reflogOutput, nil),
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p", "--follow", "--", "path"}, reflogOutput, nil),
 
 			lastReflogCommit: &models.Commit{
 				Hash:          "c3c4b66b64c97ffeecde",
@@ -127,11 +133,12 @@ func TestGetReflogCommits(t *testing.T) {
 			filterPath: "path",
 			expectedCommits: []*models.Commit{
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from A to B",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from A to B",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150700,
+					Parents:         []string{"51baa8c1"},
 				},
 			},
 			expectedOnlyObtainedNew: true,
@@ -140,7 +147,7 @@ func TestGetReflogCommits(t *testing.T) {
 		{
 			testName: "when passing filterAuthor",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p", "--author=John Doe <john@doe.com>"}, reflogOutput, nil),
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p", "--author=John Doe <john@doe.com>"}, reflogOutput, nil),
 
 			lastReflogCommit: &models.Commit{
 				Hash:          "c3c4b66b64c97ffeecde",
@@ -152,11 +159,12 @@ func TestGetReflogCommits(t *testing.T) {
 			filterAuthor: "John Doe <john@doe.com>",
 			expectedCommits: []*models.Commit{
 				{
-					Hash:          "c3c4b66b64c97ffeecde",
-					Name:          "checkout: moving from A to B",
-					Status:        models.StatusReflog,
-					UnixTimestamp: 1643150483,
-					Parents:       []string{"51baa8c1"},
+					Hash:            "c3c4b66b64c97ffeecde",
+					Name:            "checkout: moving from A to B",
+					Status:          models.StatusReflog,
+					UnixTimestamp:   1643150483,
+					ReflogTimestamp: 1643150700,
+					Parents:         []string{"51baa8c1"},
 				},
 			},
 			expectedOnlyObtainedNew: true,
@@ -165,7 +173,7 @@ func TestGetReflogCommits(t *testing.T) {
 		{
 			testName: "when command returns error",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--format=%h%x00%ct%x00%gs%x00%p"}, "", errors.New("haha")),
+				ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p"}, "", errors.New("haha")),
 
 			lastReflogCommit:        nil,
 			filterPath:              "",
@@ -192,3 +200,38 @@ func TestGetReflogCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBranchReflogCommits(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-c", "log.showSignature=false", "log", "-g", "--abbrev=40", "--date=unix", "--format=%h%x00%ct%x00%gd%x00%gs%x00%p", "refs/remotes/origin/feature", "--"},
+			strings.Replace(`b3f1c2d|1643150483|refs/remotes/origin/feature@{1643160000}|fetch: forced-update|a1b2c3d
+e5f6a7b|1643149435|refs/remotes/origin/feature@{1643150000}|fetch: fast-forward|
+`, "|", "\x00", -1), nil)
+
+	builder := &ReflogCommitLoader{
+		Common: utils.NewDummyCommon(),
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+	}
+
+	commits, err := builder.GetBranchReflogCommits("refs/remotes/origin/feature")
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Commit{
+		{
+			Hash:            "b3f1c2d",
+			Name:            "fetch: forced-update",
+			Status:          models.StatusReflog,
+			UnixTimestamp:   1643150483,
+			ReflogTimestamp: 1643160000,
+			Parents:         []string{"a1b2c3d"},
+		},
+		{
+			Hash:            "e5f6a7b",
+			Name:            "fetch: fast-forward",
+			Status:          models.StatusReflog,
+			UnixTimestamp:   1643149435,
+			ReflogTimestamp: 1643150000,
+			Parents:         []string{},
+		},
+	}, commits)
+	runner.CheckForMissingCalls()
+}
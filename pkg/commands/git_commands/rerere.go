@@ -0,0 +1,65 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+)
+
+type RerereCommands struct {
+	*GitCommon
+}
+
+func NewRerereCommands(gitCommon *GitCommon) *RerereCommands {
+	return &RerereCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+func (self *RerereCommands) IsEnabled() bool {
+	return rerereEnabled(self.Fs, self.repoPaths, self.config)
+}
+
+// Forgets the resolution that rerere has recorded for the conflicts in the
+// given file, and recreates the conflict markers in it so that the conflicts
+// can be resolved again
+func (self *RerereCommands) Forget(path string) error {
+	cmdArgs := NewGitCmd("rerere").Arg("forget", "--", path).ToArgv()
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+
+	cmdArgs = newCheckoutCommand().Arg("--merge", "--", path).ToArgv()
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Like git itself, we consider rerere to be enabled if rerere.enabled is true,
+// or if it's unset and the rr-cache directory exists
+func rerereEnabled(fs afero.Fs, repoPaths *RepoPaths, config *ConfigCommands) bool {
+	if enabled, isSet := config.GetRerereEnabled(); isSet {
+		return enabled
+	}
+
+	exists, err := afero.DirExists(fs, filepath.Join(repoPaths.RepoGitDirPath(), "rr-cache"))
+	return err == nil && exists
+}
+
+// Returns the paths with conflicts that rerere has not resolved, or nil if
+// they couldn't be determined
+func rerereRemainingPaths(cmd oscommands.ICmdObjBuilder) map[string]bool {
+	cmdArgs := NewGitCmd("rerere").Config("core.quotePath=false").Arg("remaining").ToArgv()
+	output, err := cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	result := map[string]bool{}
+	for _, path := range strings.Split(strings.TrimSpace(output), "\n") {
+		if path != "" {
+			result[path] = true
+		}
+	}
+	return result
+}
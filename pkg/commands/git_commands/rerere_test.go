@@ -0,0 +1,68 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerereIsEnabled(t *testing.T) {
+	type scenario struct {
+		testName      string
+		gitConfig     map[string]string
+		hasRerereDir  bool
+		expectEnabled bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "not configured",
+			expectEnabled: false,
+		},
+		{
+			testName:      "enabled in config",
+			gitConfig:     map[string]string{"rerere.enabled": "true"},
+			expectEnabled: true,
+		},
+		{
+			testName:      "not configured, but rr-cache exists",
+			hasRerereDir:  true,
+			expectEnabled: true,
+		},
+		{
+			testName:      "disabled in config although rr-cache exists",
+			gitConfig:     map[string]string{"rerere.enabled": "false"},
+			hasRerereDir:  true,
+			expectEnabled: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if s.hasRerereDir {
+				assert.NoError(t, fs.MkdirAll(".git/rr-cache", 0o755))
+			}
+
+			instance := buildRerereCommands(commonDeps{
+				fs:        fs,
+				repoPaths: MockRepoPaths(""),
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfig),
+			})
+			assert.Equal(t, s.expectEnabled, instance.IsEnabled())
+		})
+	}
+}
+
+func TestRerereForget(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rerere", "forget", "--", "file.txt"}, "", nil).
+		ExpectGitArgs([]string{"-c", disableHooksFlag, "checkout", "--merge", "--", "file.txt"}, "", nil)
+
+	instance := buildRerereCommands(commonDeps{runner: runner})
+	assert.NoError(t, instance.Forget("file.txt"))
+	runner.CheckForMissingCalls()
+}
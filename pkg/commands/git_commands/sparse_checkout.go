@@ -0,0 +1,109 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type SparseCheckoutCommands struct {
+	*GitCommon
+}
+
+func NewSparseCheckoutCommands(gitCommon *GitCommon) *SparseCheckoutCommands {
+	return &SparseCheckoutCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// Returns the sparse-checkout configuration of the current worktree, or nil
+// if the worktree is not sparse
+func (self *SparseCheckoutCommands) Get() *models.SparseCheckout {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("list").ToArgv()
+
+	// git errors out with "this worktree is not sparse" if sparse-checkout
+	// isn't enabled
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	return &models.SparseCheckout{
+		IsConeMode: self.isConeMode(),
+		Patterns:   utils.SplitLines(output),
+	}
+}
+
+// We're not going through the cached git config here because the mode can
+// change while lazygit is running
+func (self *SparseCheckoutCommands) isConeMode() bool {
+	cmdArgs := NewGitCmd("config").Arg("--get", "--bool", "core.sparseCheckoutCone").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSpace(output) == "true"
+}
+
+// Returns all directories tracked in HEAD, including the ones that are not
+// present in the working tree because they are outside the sparse-checkout
+func (self *SparseCheckoutCommands) AllDirectories() ([]string, error) {
+	cmdArgs := NewGitCmd("ls-tree").Arg("-d", "-r", "--name-only", "HEAD").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
+
+// Enables sparse-checkout in cone mode, checking out only the given
+// directories (plus the files at the root of the repo)
+func (self *SparseCheckoutCommands) Enable(dirs []string) error {
+	if self.version.IsOlderThan(2, 35, 0) {
+		// `set --cone` is not supported before git 2.35
+		cmdArgs := NewGitCmd("sparse-checkout").Arg("init", "--cone").ToArgv()
+		if err := self.cmd.New(cmdArgs).Run(); err != nil {
+			return err
+		}
+
+		return self.Set(dirs)
+	}
+
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("set", "--cone").Arg(dirs...).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Replaces the current sparse-checkout patterns with the given ones, keeping
+// the current mode
+func (self *SparseCheckoutCommands) Set(patterns []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("set").Arg(patterns...).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *SparseCheckoutCommands) Add(patterns []string) error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("add").Arg(patterns...).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Updates the working tree to match the sparse-checkout patterns again, e.g.
+// after files outside the cone were materialized by a merge or checkout
+func (self *SparseCheckoutCommands) Reapply() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("reapply").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Turns off sparse-checkout, populating the working tree with all files
+func (self *SparseCheckoutCommands) Disable() error {
+	cmdArgs := NewGitCmd("sparse-checkout").Arg("disable").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
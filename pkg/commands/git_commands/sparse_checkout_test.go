@@ -0,0 +1,137 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseCheckoutGet(t *testing.T) {
+	type scenario struct {
+		testName string
+		runner   *oscommands.FakeCmdObjRunner
+		expected *models.SparseCheckout
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "not sparse",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "", errors.New("fatal: this worktree is not sparse")),
+			expected: nil,
+		},
+		{
+			testName: "cone mode",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "pkg/app\ndocs\n", nil).
+				ExpectGitArgs([]string{"config", "--get", "--bool", "core.sparseCheckoutCone"}, "true\n", nil),
+			expected: &models.SparseCheckout{IsConeMode: true, Patterns: []string{"pkg/app", "docs"}},
+		},
+		{
+			testName: "cone mode with only the root directory",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "", nil).
+				ExpectGitArgs([]string{"config", "--get", "--bool", "core.sparseCheckoutCone"}, "true\n", nil),
+			expected: &models.SparseCheckout{IsConeMode: true, Patterns: []string{}},
+		},
+		{
+			testName: "non-cone mode",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "list"}, "/*\n!/vendor/\n", nil).
+				ExpectGitArgs([]string{"config", "--get", "--bool", "core.sparseCheckoutCone"}, "", errors.New("")),
+			expected: &models.SparseCheckout{IsConeMode: false, Patterns: []string{"/*", "!/vendor/"}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{runner: s.runner})
+
+			assert.Equal(t, s.expected, instance.Get())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSparseCheckoutAllDirectories(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"ls-tree", "-d", "-r", "--name-only", "HEAD"}, "docs\npkg\npkg/app\n", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	dirs, err := instance.AllDirectories()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docs", "pkg", "pkg/app"}, dirs)
+	runner.CheckForMissingCalls()
+}
+
+func TestSparseCheckoutEnable(t *testing.T) {
+	type scenario struct {
+		testName   string
+		gitVersion *GitVersion
+		runner     *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "recent git version",
+			gitVersion: &GitVersion{2, 35, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "--cone", "pkg/app", "docs"}, "", nil),
+		},
+		{
+			testName:   "old git version",
+			gitVersion: &GitVersion{2, 34, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"sparse-checkout", "init", "--cone"}, "", nil).
+				ExpectGitArgs([]string{"sparse-checkout", "set", "pkg/app", "docs"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSparseCheckoutCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+
+			assert.NoError(t, instance.Enable([]string{"pkg/app", "docs"}))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSparseCheckoutSet(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "set", "pkg/app"}, "", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Set([]string{"pkg/app"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestSparseCheckoutAdd(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "add", "docs"}, "", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Add([]string{"docs"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestSparseCheckoutReapply(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "reapply"}, "", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Reapply())
+	runner.CheckForMissingCalls()
+}
+
+func TestSparseCheckoutDisable(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"sparse-checkout", "disable"}, "", nil)
+	instance := buildSparseCheckoutCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Disable())
+	runner.CheckForMissingCalls()
+}
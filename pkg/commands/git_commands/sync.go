@@ -130,3 +130,35 @@ func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
+
+// FetchPullRequest fetches the head of a pull request into the given local
+// branch, creating it if it doesn't exist yet. As with any fetch into a local
+// branch, an existing branch is only fast-forwarded.
+func (self *SyncCommands) FetchPullRequest(task gocui.Task, remoteName string, headRef string, branchName string) error {
+	cmdArgs := self.fetchCommandBuilder(false).
+		Arg(remoteName, headRef+":refs/heads/"+branchName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Pushes the notes ref that we show notes from (see getNotesRef)
+func (self *SyncCommands) PushNotes(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("push").
+		Arg(remoteName, getNotesRef(self.UserConfig(), self.config)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Fetches the notes ref that we show notes from (see getNotesRef) straight
+// into the local ref of the same name, since notes don't have remote-tracking
+// refs
+func (self *SyncCommands) FetchNotes(task gocui.Task, remoteName string) error {
+	notesRef := getNotesRef(self.UserConfig(), self.config)
+	cmdArgs := self.fetchCommandBuilder(false).
+		Arg(remoteName, notesRef+":"+notesRef).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
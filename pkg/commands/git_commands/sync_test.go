@@ -179,3 +179,30 @@ func TestSyncFetchBackground(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncPushNotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "origin", "refs/notes/commits"}, "", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.PushNotes(gocui.NewFakeTask(), "origin"))
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncFetchNotes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"fetch", "origin", "refs/notes/commits:refs/notes/commits"}, "", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.FetchNotes(gocui.NewFakeTask(), "origin"))
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncFetchPullRequest(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"fetch", "origin", "refs/pull/5/head:refs/heads/pr/5"}, "", nil)
+	instance := buildSyncCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.FetchPullRequest(gocui.NewFakeTask(), "origin", "refs/pull/5/head", "pr/5"))
+	runner.CheckForMissingCalls()
+}
@@ -1,6 +1,12 @@
 package git_commands
 
-import "github.com/jesseduffield/gocui"
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
 
 type TagCommands struct {
 	*GitCommon
@@ -41,16 +47,72 @@ func (self *TagCommands) HasTag(tagName string) bool {
 	return self.cmd.New(cmdArgs).Run() == nil
 }
 
-func (self *TagCommands) LocalDelete(tagName string) error {
-	cmdArgs := NewGitCmd("tag").Arg("-d", tagName).
+func (self *TagCommands) LocalDelete(tagNames []string) error {
+	cmdArgs := NewGitCmd("tag").Arg("-d").Arg(tagNames...).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
-func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string) error {
-	cmdArgs := NewGitCmd("push").Arg(remoteName, "tag", tagName).
+func (self *TagCommands) Push(task gocui.Task, remoteName string, tagNames []string) error {
+	cmdArgs := NewGitCmd("push").Arg(remoteName)
+	for _, tagName := range tagNames {
+		cmdArgs.Arg("tag", tagName)
+	}
+
+	return self.cmd.New(cmdArgs.ToArgv()).PromptOnCredentialRequest(task).Run()
+}
+
+// Verify checks the signature of the tag, returning gpg's (or ssh's) verdict.
+// An unsigned tag or a bad signature results in an error.
+func (self *TagCommands) Verify(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("tag").Arg("-v", tagName).
+		ToArgv()
+
+	// The tag's content goes to stdout, the verdict to stderr
+	_, stderr, err := self.cmd.New(cmdArgs).RunWithOutputs()
+	return strings.TrimSpace(stderr), err
+}
+
+// GetRemoteTagNames returns the names of the tags that the remote has. This
+// talks to the remote, but never prompts for credentials, so that it can be
+// done in the background.
+func (self *TagCommands) GetRemoteTagNames(remoteName string) ([]string, error) {
+	cmdArgs := NewGitCmd("ls-remote").Arg("--tags", "--refs", remoteName).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+	cmdObj := self.cmd.New(cmdArgs).AddEnvVars("GIT_TERMINAL_PROMPT=0")
+	if sshCommand := self.batchModeSshCommand(); sshCommand != "" {
+		cmdObj.AddEnvVars("GIT_SSH_COMMAND=" + sshCommand)
+	}
+
+	output, err := cmdObj.DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (string, bool) {
+		_, ref, found := strings.Cut(line, "\t")
+		return strings.TrimPrefix(ref, "refs/tags/"), found
+	}), nil
+}
+
+// GIT_TERMINAL_PROMPT only stops git itself from prompting; ssh asks for
+// passphrases and host key confirmations on /dev/tty regardless. So we run
+// whatever ssh command git would use in batch mode, which makes it fail
+// instead. Returns an empty string if the user has set GIT_SSH, because git
+// doesn't run that through a shell, so we can't add options to it.
+func (self *TagCommands) batchModeSshCommand() string {
+	sshCommand := self.os.Getenv("GIT_SSH_COMMAND")
+	if sshCommand == "" {
+		sshCommand = self.config.GetCoreSshCommand()
+	}
+	if sshCommand == "" {
+		if self.os.Getenv("GIT_SSH") != "" {
+			return ""
+		}
+		sshCommand = "ssh"
+	}
+
+	return sshCommand + " -o BatchMode=yes"
 }
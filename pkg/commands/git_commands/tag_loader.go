@@ -1,12 +1,13 @@
 package git_commands
 
 import (
-	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/common"
-	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -25,32 +26,61 @@ func NewTagLoader(
 	}
 }
 
+// The fields we get for each tag, separated by NUL characters. Each tag ends
+// with an additional NUL (followed by the newline that for-each-ref adds), so
+// that the body can span multiple lines.
+var tagFields = []string{
+	"%(refname:strip=2)",
+	"%(objecttype)",
+	"%(taggername)",
+	"%(taggeremail)",
+	"%(creatordate:unix)",
+	"%(if)%(contents:signature)%(then)1%(end)",
+	"%(contents:subject)",
+	"%(contents:body)",
+}
+
 func (self *TagLoader) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
+	// get tags, sorted by creation date (descending)
 	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	cmdArgs := NewGitCmd("tag").Arg("--list", "-n", "--sort=-creatordate").ToArgv()
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--sort=-creatordate").
+		Arg("--format=" + strings.Join(tagFields, "%00") + "%00").
+		Arg("refs/tags").
+		ToArgv()
 	tagsOutput, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 	if err != nil {
 		return nil, err
 	}
 
-	split := utils.SplitLines(tagsOutput)
+	records := lo.Filter(strings.Split(tagsOutput, "\x00\n"), func(record string, _ int) bool {
+		return record != ""
+	})
 
-	lineRegex := regexp.MustCompile(`^([^\s]+)(\s+)?(.*)$`)
+	return lo.FilterMap(records, func(record string, _ int) (*models.Tag, bool) {
+		return parseTag(record)
+	}), nil
+}
 
-	tags := lo.Map(split, func(line string, _ int) *models.Tag {
-		matches := lineRegex.FindStringSubmatch(line)
-		tagName := matches[1]
-		message := ""
-		if len(matches) > 3 {
-			message = matches[3]
-		}
+func parseTag(record string) (*models.Tag, bool) {
+	fields := strings.Split(record, "\x00")
+	if len(fields) != len(tagFields) {
+		return nil, false
+	}
 
-		return &models.Tag{
-			Name:    tagName,
-			Message: message,
-		}
-	})
+	tag := &models.Tag{
+		Name:        fields[0],
+		IsAnnotated: fields[1] == "tag",
+		IsSigned:    fields[5] != "",
+		Message:     fields[6],
+		Body:        strings.TrimSpace(fields[7]),
+	}
+	if tag.IsAnnotated {
+		tag.Tagger = strings.TrimSpace(fields[2] + " " + fields[3])
+	}
+	if timestamp, err := strconv.ParseInt(fields[4], 10, 64); err == nil {
+		tag.Date = time.Unix(timestamp, 0)
+	}
 
-	return tags, nil
+	return tag, true
 }
@@ -2,6 +2,7 @@ package git_commands
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -9,10 +10,11 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `tag1 this is my message
-tag2
-tag3 this is my other message
-`
+const tagsFormat = "--format=%(refname:strip=2)%00%(objecttype)%00%(taggername)%00%(taggeremail)%00%(creatordate:unix)%00%(if)%(contents:signature)%(then)1%(end)%00%(contents:subject)%00%(contents:body)%00"
+
+const tagsOutput = "tag1\x00tag\x00Jesse Duffield\x00<jesse@example.com>\x001700000000\x001\x00this is my message\x00with a body\nthat has two lines\n\x00\n" +
+	"tag2\x00commit\x00\x00\x001690000000\x00\x00\x00\x00\n" +
+	"tag3\x00commit\x00\x00\x001680000000\x00\x00this is my other message\x00\x00\n"
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
@@ -26,18 +28,26 @@ func TestGetTags(t *testing.T) {
 		{
 			testName: "should return no tags if there are none",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, "", nil),
+				ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
 			testName: "should return tags if present",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, tagsOutput, nil),
+				ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, tagsOutput, nil),
 			expectedTags: []*models.Tag{
-				{Name: "tag1", Message: "this is my message"},
-				{Name: "tag2", Message: ""},
-				{Name: "tag3", Message: "this is my other message"},
+				{
+					Name:        "tag1",
+					Message:     "this is my message",
+					Body:        "with a body\nthat has two lines",
+					IsAnnotated: true,
+					Tagger:      "Jesse Duffield <jesse@example.com>",
+					Date:        time.Unix(1700000000, 0),
+					IsSigned:    true,
+				},
+				{Name: "tag2", Message: "", Date: time.Unix(1690000000, 0)},
+				{Name: "tag3", Message: "this is my other message", Date: time.Unix(1680000000, 0)},
 			},
 			expectedError: nil,
 		},
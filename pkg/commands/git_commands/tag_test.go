@@ -0,0 +1,137 @@
+package git_commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagLocalDelete(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"tag", "-d", "v1.0", "v1.1"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.LocalDelete([]string{"v1.0", "v1.1"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagPush(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "origin", "tag", "v1.0", "tag", "v1.1"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.Push(gocui.NewFakeTask(), "origin", []string{"v1.0", "v1.1"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagVerify(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"tag", "-v", "v1.0"}, "", errors.New("error: no signature found"))
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	_, err := instance.Verify("v1.0")
+	assert.EqualError(t, err, "error: no signature found")
+	runner.CheckForMissingCalls()
+}
+
+func TestTagGetRemoteTagNames(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		output        string
+		err           error
+		expectedNames []string
+		expectedErr   string
+	}{
+		{
+			testName: "some tags",
+			output: "0bd4e4e1d2e5a0c4fbe3b0c9d6b3ab0f9d3b9c41\trefs/tags/v1.0\n" +
+				"8a1f4f7de6d0b1b1a7f5b4c1e2f3a4b5c6d7e8f9\trefs/tags/release/v1.1\n",
+			expectedNames: []string{"v1.0", "release/v1.1"},
+		},
+		{
+			testName:      "no tags",
+			output:        "",
+			expectedNames: []string{},
+		},
+		{
+			testName:    "remote can't be reached",
+			err:         errors.New("fatal: could not read from remote repository"),
+			expectedErr: "fatal: could not read from remote repository",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"ls-remote", "--tags", "--refs", "origin"}, s.output, s.err)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			names, err := instance.GetRemoteTagNames("origin")
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedNames, names)
+			}
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestTagGetRemoteTagNamesSshCommand(t *testing.T) {
+	scenarios := []struct {
+		testName           string
+		env                map[string]string
+		gitConfig          map[string]string
+		expectedSshCommand string
+	}{
+		{
+			testName:           "default ssh",
+			expectedSshCommand: "ssh -o BatchMode=yes",
+		},
+		{
+			testName:           "GIT_SSH_COMMAND is set",
+			env:                map[string]string{"GIT_SSH_COMMAND": "ssh -i ~/.ssh/work"},
+			gitConfig:          map[string]string{"core.sshCommand": "ssh -i ~/.ssh/other"},
+			expectedSshCommand: "ssh -i ~/.ssh/work -o BatchMode=yes",
+		},
+		{
+			testName:           "core.sshCommand is set",
+			gitConfig:          map[string]string{"core.sshCommand": "ssh -i ~/.ssh/other"},
+			expectedSshCommand: "ssh -i ~/.ssh/other -o BatchMode=yes",
+		},
+		{
+			testName:           "GIT_SSH is set",
+			env:                map[string]string{"GIT_SSH": "plink"},
+			expectedSshCommand: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectFunc("runs ls-remote with the expected ssh command", func(cmdObj oscommands.ICmdObj) bool {
+					sshCommand, _ := lo.Find(cmdObj.GetEnvVars(), func(envVar string) bool {
+						return strings.HasPrefix(envVar, "GIT_SSH_COMMAND=")
+					})
+					return strings.TrimPrefix(sshCommand, "GIT_SSH_COMMAND=") == s.expectedSshCommand &&
+						lo.Contains(cmdObj.GetEnvVars(), "GIT_TERMINAL_PROMPT=0")
+				}, "", nil)
+			instance := buildTagCommands(commonDeps{
+				runner:    runner,
+				getenv:    func(key string) string { return s.env[key] },
+				gitConfig: git_config.NewFakeGitConfig(s.gitConfig),
+			})
+
+			_, err := instance.GetRemoteTagNames("origin")
+			assert.NoError(t, err)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
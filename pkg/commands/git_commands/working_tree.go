@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type WorkingTreeCommands struct {
@@ -32,6 +34,35 @@ func (self *WorkingTreeCommands) OpenMergeToolCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(NewGitCmd("mergetool").ToArgv())
 }
 
+// Resolves the merge conflicts of the given file by taking the given version
+// of it from the index, and stages the result. If that version doesn't exist
+// (e.g. ours, when we deleted the file), the file is removed instead.
+func (self *WorkingTreeCommands) ResolveConflictWithVersion(file *models.File, version models.ConflictVersion) error {
+	if !file.HasConflictVersion(version) {
+		return self.cmd.New(
+			NewGitCmd("rm").Arg("--", file.Name).ToArgv(),
+		).Run()
+	}
+
+	var cmdArgs []string
+	switch version {
+	case models.CONFLICT_VERSION_OURS:
+		cmdArgs = newCheckoutCommand().Arg("--ours", "--", file.Name).ToArgv()
+	case models.CONFLICT_VERSION_THEIRS:
+		cmdArgs = newCheckoutCommand().Arg("--theirs", "--", file.Name).ToArgv()
+	case models.CONFLICT_VERSION_BASE:
+		// `git checkout` has no option for the base version, so we check out
+		// its index stage directly
+		cmdArgs = NewGitCmd("checkout-index").Arg("--force", "--stage=1", "--", file.Name).ToArgv()
+	}
+
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+
+	return self.StageFile(file.Name)
+}
+
 // StageFile stages a file
 func (self *WorkingTreeCommands) StageFile(path string) error {
 	return self.StageFiles([]string{path})
@@ -390,3 +421,194 @@ func (self *WorkingTreeCommands) ResetMixed(ref string) error {
 
 	return self.cmd.New(cmdArgs).Run()
 }
+
+// CreateSnapshot writes the current index and working tree (including
+// untracked, but not ignored, files) to the object database, without touching
+// either of them. If paths are given, only the working tree files at those
+// paths are written, and restoring the snapshot only touches those paths;
+// otherwise it covers the whole working tree.
+func (self *WorkingTreeCommands) CreateSnapshot(paths []string) (*models.FileSnapshot, error) {
+	indexTree, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	// The working tree is written via a temporary copy of the index so that
+	// the real one stays untouched
+	tmpIndexPath, err := self.copyIndexToTempFile()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tmpIndexPath) }()
+
+	indexEnvVar := "GIT_INDEX_FILE=" + tmpIndexPath
+	if err := self.stageSnapshotPaths(indexEnvVar, paths); err != nil {
+		return nil, err
+	}
+
+	workingTreeTree, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).
+		AddEnvVars(indexEnvVar).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.FileSnapshot{
+		IndexTree:       strings.TrimSpace(indexTree),
+		WorkingTreeTree: strings.TrimSpace(workingTreeTree),
+		Paths:           paths,
+	}, nil
+}
+
+// Stages the given paths (or everything, if there are none) in the index
+// given by the env var
+func (self *WorkingTreeCommands) stageSnapshotPaths(indexEnvVar string, paths []string) error {
+	if len(paths) == 0 {
+		return self.cmd.New(NewGitCmd("add").Arg("--all").ToArgv()).
+			AddEnvVars(indexEnvVar).DontLog().Run()
+	}
+
+	// git add fails for a path that matches neither a file nor an index entry,
+	// so paths that don't exist (any more) are unstaged instead
+	existingPaths, missingPaths := []string{}, []string{}
+	for _, path := range paths {
+		if _, err := os.Lstat(path); err == nil {
+			existingPaths = append(existingPaths, path)
+		} else {
+			missingPaths = append(missingPaths, path)
+		}
+	}
+
+	if len(existingPaths) > 0 {
+		if err := self.cmd.New(NewGitCmd("add").Arg("--all", "--").Arg(existingPaths...).ToArgv()).
+			AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+			return err
+		}
+	}
+
+	if len(missingPaths) > 0 {
+		if err := self.cmd.New(NewGitCmd("rm").Arg("--cached", "-r", "-q", "--ignore-unmatch", "--").Arg(missingPaths...).ToArgv()).
+			AddEnvVars(indexEnvVar).DontLog().Run(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Copies the index to a uniquely named temporary file, so that we can stage
+// things in it without racing with other git processes (or lazygit
+// instances). Copying the index (rather than reading HEAD into an empty one)
+// means git can use its cached stat info and doesn't have to rehash every
+// file.
+func (self *WorkingTreeCommands) copyIndexToTempFile() (string, error) {
+	file, err := os.CreateTemp(self.os.GetTempDir(), "index-")
+	if err != nil {
+		return "", err
+	}
+	_ = file.Close()
+
+	if err := oscommands.CopyFile(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index"), file.Name()); err != nil {
+		// git treats a missing index file as an empty index, which is what we
+		// want if there's no index yet
+		_ = os.Remove(file.Name())
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	return file.Name(), nil
+}
+
+// RestoreSnapshot resets the index and the working tree at the snapshot's
+// paths to how they were when the snapshot was taken. This includes deleting
+// untracked files that didn't exist back then; ignored files are left alone.
+func (self *WorkingTreeCommands) RestoreSnapshot(snapshot *models.FileSnapshot) error {
+	if len(snapshot.Paths) == 0 {
+		return self.restoreWholeSnapshot(snapshot)
+	}
+
+	snapshotFiles, err := self.listFiles(
+		NewGitCmd("ls-tree").Arg("-r", "-z", "--name-only", snapshot.WorkingTreeTree, "--").Arg(snapshot.Paths...),
+	)
+	if err != nil {
+		return err
+	}
+
+	currentFiles, err := self.listFiles(
+		NewGitCmd("ls-files").Arg("-z", "--cached", "--others", "--exclude-standard", "--").Arg(snapshot.Paths...),
+	)
+	if err != nil {
+		return err
+	}
+
+	snapshotFileSet := lo.SliceToMap(snapshotFiles, func(path string) (string, bool) { return path, true })
+	for _, path := range currentFiles {
+		if snapshotFileSet[path] {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	// Checking out a path that doesn't exist in the snapshot is an error
+	pathsInSnapshot := lo.Filter(snapshot.Paths, func(path string, _ int) bool {
+		return lo.ContainsBy(snapshotFiles, func(file string) bool {
+			return file == path || strings.HasPrefix(file, path+"/")
+		})
+	})
+	if len(pathsInSnapshot) > 0 {
+		if err := self.cmd.New(
+			newCheckoutCommand().Arg(snapshot.WorkingTreeTree, "--").Arg(pathsInSnapshot...).ToArgv(),
+		).Run(); err != nil {
+			return err
+		}
+	}
+
+	// Checking out the files also staged them, so put back the snapshotted
+	// index without touching the files
+	return self.cmd.New(
+		NewGitCmd("reset").Arg("-q", snapshot.IndexTree, "--").Arg(snapshot.Paths...).ToArgv(),
+	).Run()
+}
+
+func (self *WorkingTreeCommands) restoreWholeSnapshot(snapshot *models.FileSnapshot) error {
+	// Staging everything first makes read-tree aware of the untracked files,
+	// so that it removes the ones that aren't part of the snapshot
+	if err := self.cmd.New(NewGitCmd("add").Arg("--all").ToArgv()).Run(); err != nil {
+		return err
+	}
+
+	// Then make the working tree match the snapshot (this also updates the
+	// index), and put back the snapshotted index without touching the files
+	if err := self.cmd.New(
+		NewGitCmd("read-tree").Arg("--reset", "-u", snapshot.WorkingTreeTree).ToArgv(),
+	).Run(); err != nil {
+		return err
+	}
+
+	return self.cmd.New(
+		NewGitCmd("read-tree").Arg("--reset", snapshot.IndexTree).ToArgv(),
+	).Run()
+}
+
+func (self *WorkingTreeCommands) listFiles(cmd *GitCommandBuilder) ([]string, error) {
+	output, err := self.cmd.New(cmd.ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(strings.Split(output, "\x00"), func(path string, _ int) bool { return path != "" }), nil
+}
+
+// HeadHash returns the hash of HEAD, or an empty string if there are no
+// commits yet
+func (self *WorkingTreeCommands) HeadHash() string {
+	output, err := self.cmd.New(NewGitCmd("rev-parse").Arg("--verify", "--quiet", "HEAD").ToArgv()).
+		DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
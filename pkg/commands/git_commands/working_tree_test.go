@@ -206,6 +206,64 @@ func TestWorkingTreeDiscardAllFileChanges(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeResolveConflictWithVersion(t *testing.T) {
+	type scenario struct {
+		testName string
+		file     *models.File
+		version  models.ConflictVersion
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "ours",
+			file:     &models.File{Name: "test", ShortStatus: "UU"},
+			version:  models.CONFLICT_VERSION_OURS,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", disableHooksFlag, "checkout", "--ours", "--", "test"}, "", nil).
+				ExpectGitArgs([]string{"add", "--", "test"}, "", nil),
+		},
+		{
+			testName: "theirs",
+			file:     &models.File{Name: "test", ShortStatus: "AA"},
+			version:  models.CONFLICT_VERSION_THEIRS,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", disableHooksFlag, "checkout", "--theirs", "--", "test"}, "", nil).
+				ExpectGitArgs([]string{"add", "--", "test"}, "", nil),
+		},
+		{
+			testName: "base",
+			file:     &models.File{Name: "test", ShortStatus: "UU"},
+			version:  models.CONFLICT_VERSION_BASE,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"checkout-index", "--force", "--stage=1", "--", "test"}, "", nil).
+				ExpectGitArgs([]string{"add", "--", "test"}, "", nil),
+		},
+		{
+			testName: "ours when deleted by us",
+			file:     &models.File{Name: "test", ShortStatus: "DU"},
+			version:  models.CONFLICT_VERSION_OURS,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rm", "--", "test"}, "", nil),
+		},
+		{
+			testName: "base when added by both",
+			file:     &models.File{Name: "test", ShortStatus: "AA"},
+			version:  models.CONFLICT_VERSION_BASE,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rm", "--", "test"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.ResolveConflictWithVersion(s.file, s.version))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestWorkingTreeDiff(t *testing.T) {
 	type scenario struct {
 		testName            string
@@ -569,3 +627,79 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeCreateSnapshot(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		paths         []string
+		expectedStage func(*oscommands.FakeCmdObjRunner) *oscommands.FakeCmdObjRunner
+	}{
+		{
+			testName: "whole working tree",
+			paths:    nil,
+			expectedStage: func(runner *oscommands.FakeCmdObjRunner) *oscommands.FakeCmdObjRunner {
+				return runner.ExpectGitArgs([]string{"add", "--all"}, "", nil)
+			},
+		},
+		{
+			// working_tree.go exists in the test's working directory
+			testName: "existing and missing paths",
+			paths:    []string{"working_tree.go", "deleted-file"},
+			expectedStage: func(runner *oscommands.FakeCmdObjRunner) *oscommands.FakeCmdObjRunner {
+				return runner.
+					ExpectGitArgs([]string{"add", "--all", "--", "working_tree.go"}, "", nil).
+					ExpectGitArgs([]string{"rm", "--cached", "-r", "-q", "--ignore-unmatch", "--", "deleted-file"}, "", nil)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := s.expectedStage(oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"write-tree"}, "index-tree\n", nil)).
+				ExpectGitArgs([]string{"write-tree"}, "working-tree-tree\n", nil)
+
+			instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+			snapshot, err := instance.CreateSnapshot(s.paths)
+			assert.NoError(t, err)
+			assert.Equal(t, &models.FileSnapshot{IndexTree: "index-tree", WorkingTreeTree: "working-tree-tree", Paths: s.paths}, snapshot)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeRestoreSnapshot(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		snapshot *models.FileSnapshot
+		runner   *oscommands.FakeCmdObjRunner
+	}{
+		{
+			testName: "whole working tree",
+			snapshot: &models.FileSnapshot{IndexTree: "index-tree", WorkingTreeTree: "working-tree-tree"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"add", "--all"}, "", nil).
+				ExpectGitArgs([]string{"read-tree", "--reset", "-u", "working-tree-tree"}, "", nil).
+				ExpectGitArgs([]string{"read-tree", "--reset", "index-tree"}, "", nil),
+		},
+		{
+			testName: "some paths",
+			snapshot: &models.FileSnapshot{IndexTree: "index-tree", WorkingTreeTree: "working-tree-tree", Paths: []string{"dir", "deleted-file"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"ls-tree", "-r", "-z", "--name-only", "working-tree-tree", "--", "dir", "deleted-file"}, "dir/a\x00dir/b\x00", nil).
+				ExpectGitArgs([]string{"ls-files", "-z", "--cached", "--others", "--exclude-standard", "--", "dir", "deleted-file"}, "dir/a\x00", nil).
+				// deleted-file didn't exist when the snapshot was taken, so we don't check it out
+				ExpectGitArgs([]string{"-c", disableHooksFlag, "checkout", "working-tree-tree", "--", "dir"}, "", nil).
+				ExpectGitArgs([]string{"reset", "-q", "index-tree", "--", "dir", "deleted-file"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			err := instance.RestoreSnapshot(s.snapshot)
+			assert.NoError(t, err)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
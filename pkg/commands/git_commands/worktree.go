@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 )
 
 type WorktreeCommands struct {
@@ -54,6 +55,16 @@ func (self *WorktreeCommands) Detach(worktreePath string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Shows the status of the given worktree, for displaying in the main view
+func (self *WorktreeCommands) StatusCmdObj(worktreePath string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("status").
+		Config("color.status=always").
+		Dir(worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).AddEnvVars("GIT_OPTIONAL_LOCKS=0").DontLog()
+}
+
 func WorktreeForBranch(branch *models.Branch, worktrees []*models.Worktree) (*models.Worktree, bool) {
 	for _, worktree := range worktrees {
 		if worktree.Branch == branch.Name {
@@ -1,16 +1,20 @@
 package git_commands
 
 import (
+	"fmt"
 	iofs "io/fs"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"github.com/spf13/afero"
+	"golang.org/x/sync/errgroup"
 )
 
 type WorktreeLoader struct {
@@ -141,6 +145,94 @@ func (self *WorktreeLoader) GetWorktrees() ([]*models.Worktree, error) {
 	return worktrees, nil
 }
 
+// LoadStatuses loads the status of all worktrees concurrently, and calls
+// renderFunc once they're all done. This is slow enough for large repos that we
+// do it in the background after the worktrees themselves have been loaded.
+func (self *WorktreeLoader) LoadStatuses(worktrees []*models.Worktree, renderFunc func()) error {
+	t := time.Now()
+	errg := errgroup.Group{}
+
+	for _, worktree := range worktrees {
+		if worktree.IsPathMissing {
+			continue
+		}
+
+		errg.Go(func() error {
+			status, err := self.getStatus(worktree)
+			if err != nil {
+				return err
+			}
+
+			worktree.Status.Store(status)
+			return nil
+		})
+	}
+
+	err := errg.Wait()
+	self.Log.Debugf("time to get statuses of all worktrees: %s", time.Since(t))
+	renderFunc()
+	return err
+}
+
+func (self *WorktreeLoader) getStatus(worktree *models.Worktree) (*models.WorktreeStatus, error) {
+	cmdArgs := NewGitCmd("status").
+		Arg("--porcelain=v2", "--branch").
+		Dir(worktree.Path).
+		ToArgv()
+
+	// Don't let git take the index lock to refresh its stat info; we don't
+	// want to get in the way of whatever the user is doing in that worktree
+	output, err := self.cmd.New(cmdArgs).AddEnvVars("GIT_OPTIONAL_LOCKS=0").DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	status := parseWorktreeStatus(output)
+	status.WorkingTreeState = self.workingTreeState(worktree)
+	return status, nil
+}
+
+// Parses the output of `git status --porcelain=v2 --branch`
+func parseWorktreeStatus(output string) *models.WorktreeStatus {
+	status := &models.WorktreeStatus{}
+
+	for _, line := range utils.SplitLines(output) {
+		switch {
+		case strings.HasPrefix(line, "# branch.ab "):
+			// This line only exists if the branch has an upstream
+			status.HasUpstream = true
+			_, _ = fmt.Sscanf(strings.TrimPrefix(line, "# branch.ab "), "+%d -%d", &status.Ahead, &status.Behind)
+		case strings.HasPrefix(line, "#"):
+			// other branch headers
+		case strings.HasPrefix(line, "u "):
+			status.ChangedFiles++
+			status.ConflictedFiles++
+		default:
+			status.ChangedFiles++
+		}
+	}
+
+	return status
+}
+
+func (self *WorktreeLoader) workingTreeState(worktree *models.Worktree) enums.RebaseMode {
+	if worktree.GitDir == "" {
+		return enums.REBASE_MODE_NONE
+	}
+
+	for _, dir := range []string{"rebase-merge", "rebase-apply"} {
+		if _, err := self.Fs.Stat(filepath.Join(worktree.GitDir, dir)); err == nil {
+			return enums.REBASE_MODE_REBASING
+		}
+	}
+
+	if _, err := self.Fs.Stat(filepath.Join(worktree.GitDir, "MERGE_HEAD")); err == nil {
+		return enums.REBASE_MODE_MERGING
+	}
+
+	return enums.REBASE_MODE_NONE
+}
+
 func (self *WorktreeLoader) pathExists(path string) bool {
 	if _, err := self.Fs.Stat(path); err != nil {
 		if errors.Is(err, iofs.ErrNotExist) {
@@ -6,6 +6,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
@@ -214,6 +215,51 @@ branch refs/heads/mybranch-worktree
 	}
 }
 
+func TestLoadWorktreeStatuses(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-C", "/path/to/repo", "status", "--porcelain=v2", "--branch"},
+			`# branch.oid d85cc9d281fa6ae1665c68365fc70e75e82a042d
+# branch.head mybranch
+# branch.upstream origin/mybranch
+# branch.ab +2 -1
+1 .M N... 100644 100644 100644 3b18e512dba79e4c8300dd08aeb37f8e728b8dad 3b18e512dba79e4c8300dd08aeb37f8e728b8dad file1
+u UU N... 100644 100644 100644 100644 3b18e512dba79e4c8300dd08aeb37f8e728b8dad 3b18e512dba79e4c8300dd08aeb37f8e728b8dad 3b18e512dba79e4c8300dd08aeb37f8e728b8dad file2
+? untracked
+`, nil)
+	fs := afero.NewMemMapFs()
+	_ = fs.MkdirAll("/path/to/repo/.git/rebase-merge", 0o755)
+
+	loader := &WorktreeLoader{
+		GitCommon: buildGitCommon(commonDeps{runner: runner, fs: fs}),
+	}
+
+	worktrees := []*models.Worktree{
+		{Path: "/path/to/repo", GitDir: "/path/to/repo/.git"},
+		{Path: "/path/to/missing", IsPathMissing: true},
+	}
+
+	rendered := false
+	err := loader.LoadStatuses(worktrees, func() { rendered = true })
+	assert.NoError(t, err)
+	assert.True(t, rendered)
+	runner.CheckForMissingCalls()
+
+	assert.Equal(t, &models.WorktreeStatus{
+		ChangedFiles:     3,
+		ConflictedFiles:  1,
+		HasUpstream:      true,
+		Ahead:            2,
+		Behind:           1,
+		WorkingTreeState: enums.REBASE_MODE_REBASING,
+	}, worktrees[0].Status.Load())
+	assert.Nil(t, worktrees[1].Status.Load())
+}
+
+func TestParseWorktreeStatusWithoutUpstream(t *testing.T) {
+	status := parseWorktreeStatus("# branch.oid d85cc9d281fa6ae1665c68365fc70e75e82a042d\n# branch.head mybranch\n")
+	assert.Equal(t, &models.WorktreeStatus{}, status)
+}
+
 func TestGetUniqueNamesFromPaths(t *testing.T) {
 	for _, scenario := range []struct {
 		input    []string
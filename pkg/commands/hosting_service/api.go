@@ -0,0 +1,214 @@
+package hosting_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// Besides building URLs for the browser, some hosting services can be talked
+// to through their API, e.g. to get the pull requests of a repo. Each provider
+// that supports this has an apiDefinition, which knows where the API lives and
+// how to authenticate against it, and an apiClient that does the actual work.
+
+// apiClient is implemented per provider
+type apiClient interface {
+	// Returns all open pull requests of the repo, most recently updated first,
+	// going through as many pages of results as it takes
+	getOpenPullRequests() ([]*models.PullRequest, error)
+	// Fills in the review and check status of the given pull request. Some
+	// providers already return these as part of getOpenPullRequests, in which
+	// case this does nothing.
+	loadPullRequestStatus(pr *models.PullRequest) error
+	getDefaultBranch() (string, error)
+	// Returns the created pull request, which is only partially filled in
+	// (number and URL). If creating it succeeded but adding reviewers or
+	// labels failed, it returns both the pull request and an error.
+	createPullRequest(opts CreatePullRequestOpts) (*models.PullRequest, error)
+}
+
+type CreatePullRequestOpts struct {
+	HeadBranch string
+	// The owner of the fork that the head branch is on; empty if it's on the
+	// repo itself
+	HeadOwner  string
+	BaseBranch string
+	Title      string
+	Body       string
+	IsDraft    bool
+	// Usernames; what exactly they need to look like depends on the provider
+	Reviewers []string
+	Labels    []string
+}
+
+// QualifiedHeadBranch returns the head branch in the "owner:branch" form that
+// GitHub and Gitea expect for branches on a fork
+func (self CreatePullRequestOpts) QualifiedHeadBranch() string {
+	if self.HeadOwner == "" {
+		return self.HeadBranch
+	}
+
+	return self.HeadOwner + ":" + self.HeadBranch
+}
+
+type apiDefinition struct {
+	// Returns the URL that API paths are relative to, given the domain that
+	// the service's web pages are served from
+	baseURL func(webDomain string) string
+	// Adds the token to a request
+	authorize func(req *http.Request, token string)
+	// If true, the API can't be used without a token, not even for public
+	// repos
+	requiresToken bool
+	newClient     func(requester *apiRequester, owner string, repo string) apiClient
+}
+
+// APIClient gives access to the API of the hosting service of a repo
+type APIClient struct {
+	client apiClient
+}
+
+func (self *APIClient) GetOpenPullRequests() ([]*models.PullRequest, error) {
+	return self.client.getOpenPullRequests()
+}
+
+func (self *APIClient) LoadPullRequestStatus(pr *models.PullRequest) error {
+	return self.client.loadPullRequestStatus(pr)
+}
+
+func (self *APIClient) GetDefaultBranch() (string, error) {
+	return self.client.getDefaultBranch()
+}
+
+func (self *APIClient) CreatePullRequest(opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	return self.client.createPullRequest(opts)
+}
+
+const apiRequestTimeout = 30 * time.Second
+
+// apiRequester sends JSON requests to an API and decodes the JSON responses
+type apiRequester struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+	authorize  func(req *http.Request, token string)
+}
+
+// path is relative to the base URL, unless it's a full URL
+func (self *apiRequester) url(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+
+	return strings.TrimSuffix(self.baseURL, "/") + path
+}
+
+func (self *apiRequester) get(path string, result any) error {
+	return self.do(http.MethodGet, path, nil, result)
+}
+
+func (self *apiRequester) post(path string, body any, result any) error {
+	return self.do(http.MethodPost, path, body, result)
+}
+
+func (self *apiRequester) do(method string, path string, body any, result any) error {
+	var bodyReader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, self.url(path), bodyReader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if self.token != "" {
+		self.authorize(req, self.token)
+	}
+
+	resp, err := self.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.New(apiErrorMessage(resp.Status, respBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(respBody, result)
+}
+
+// The providers disagree on how they report errors, but most of them have a
+// "message" field somewhere
+func apiErrorMessage(status string, body []byte) string {
+	var parsed struct {
+		Message string `json:"message"`
+		Error   struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &parsed) == nil {
+		if parsed.Message != "" {
+			return fmt.Sprintf("%s: %s", status, parsed.Message)
+		}
+		if parsed.Error.Message != "" {
+			return fmt.Sprintf("%s: %s", status, parsed.Error.Message)
+		}
+	}
+
+	return status
+}
+
+// Combines the statuses of several CI checks into one: any failing check
+// fails the whole thing, otherwise any pending one makes it pending
+func combineCheckStatuses(statuses []models.PullRequestCheckStatus) models.PullRequestCheckStatus {
+	result := models.PullRequestCheckStatusNone
+	for _, status := range statuses {
+		switch status {
+		case models.PullRequestCheckStatusFailure:
+			return models.PullRequestCheckStatusFailure
+		case models.PullRequestCheckStatusPending:
+			result = models.PullRequestCheckStatusPending
+		case models.PullRequestCheckStatusSuccess:
+			if result == models.PullRequestCheckStatusNone {
+				result = models.PullRequestCheckStatusSuccess
+			}
+		}
+	}
+
+	return result
+}
+
+// Returns the error for when the pull request was created, but something that
+// we tried to do with it afterwards failed
+func pullRequestCreatedButError(pr *models.PullRequest, what string, err error) error {
+	return fmt.Errorf("created pull request #%d, but could not %s: %w", pr.Number, what, err)
+}
+
+func bearerAuthorization(req *http.Request, token string) {
+	req.Header.Set("Authorization", "Bearer "+token)
+}
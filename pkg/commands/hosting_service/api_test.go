@@ -0,0 +1,447 @@
+package hosting_service
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/fakes"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAPIRequest struct {
+	method        string
+	uri           string
+	authorization string
+	body          string
+}
+
+// Serves canned responses keyed by request URI (path plus query), and records
+// the requests it gets
+func newFakeAPIServer(t *testing.T, responses map[string]string) (*httptest.Server, *[]fakeAPIRequest) {
+	requests := []fakeAPIRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, fakeAPIRequest{
+			method:        r.Method,
+			uri:           r.URL.RequestURI(),
+			authorization: r.Header.Get("Authorization"),
+			body:          string(body),
+		})
+
+		response, ok := responses[r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func newTestAPIClient(t *testing.T, remoteURL string, server *httptest.Server, token string) *APIClient {
+	mgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), remoteURL, nil)
+	mgr.apiBaseURL = server.URL
+	client, err := mgr.NewAPIClient(token)
+	assert.NoError(t, err)
+	return client
+}
+
+func TestGithubGetOpenPullRequests(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/graphql": `{"data": {"repository": {"pullRequests": {"nodes": [
+			{
+				"number": 12, "title": "Add feature", "url": "https://github.com/owner/repo/pull/12",
+				"isDraft": false, "isCrossRepository": false, "headRefName": "feature", "baseRefName": "main",
+				"reviewDecision": "APPROVED",
+				"commits": {"nodes": [{"commit": {"statusCheckRollup": {"state": "FAILURE"}}}]}
+			},
+			{
+				"number": 11, "title": "WIP", "url": "https://github.com/owner/repo/pull/11",
+				"isDraft": true, "isCrossRepository": true, "headRefName": "main", "baseRefName": "main",
+				"reviewDecision": null,
+				"commits": {"nodes": [{"commit": {"statusCheckRollup": null}}]}
+			}
+		]}}}}`,
+	})
+
+	client := newTestAPIClient(t, "git@github.com:owner/repo.git", server, "secret")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.PullRequest{
+		{
+			Number:       12,
+			Title:        "Add feature",
+			URL:          "https://github.com/owner/repo/pull/12",
+			HeadBranch:   "feature",
+			BaseBranch:   "main",
+			ReviewStatus: models.PullRequestReviewStatusApproved,
+			CheckStatus:  models.PullRequestCheckStatusFailure,
+		},
+		{
+			Number:            11,
+			Title:             "WIP",
+			URL:               "https://github.com/owner/repo/pull/11",
+			HeadBranch:        "main",
+			BaseBranch:        "main",
+			IsCrossRepository: true,
+			IsDraft:           true,
+		},
+	}, pullRequests)
+
+	assert.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "POST", request.method)
+	assert.Equal(t, "Bearer secret", request.authorization)
+	assert.Contains(t, request.body, `"variables":{"cursor":null,"owner":"owner","repo":"repo"}`)
+}
+
+func TestGithubGetOpenPullRequestsPaginated(t *testing.T) {
+	pageResponse := func(number int, hasNextPage bool) string {
+		return fmt.Sprintf(`{"data": {"repository": {"pullRequests": {
+			"pageInfo": {"hasNextPage": %t, "endCursor": "cursor-%d"},
+			"nodes": [{"number": %d, "headRefName": "branch-%d", "commits": {"nodes": []}}]
+		}}}}`, hasNextPage, number, number, number)
+	}
+
+	bodies := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		switch {
+		case strings.Contains(string(body), `"cursor":null`):
+			_, _ = w.Write([]byte(pageResponse(1, true)))
+		case strings.Contains(string(body), `"cursor":"cursor-1"`):
+			_, _ = w.Write([]byte(pageResponse(2, false)))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestAPIClient(t, "git@github.com:owner/repo.git", server, "secret")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"branch-1", "branch-2"}, lo.Map(pullRequests, func(pr *models.PullRequest, _ int) string {
+		return pr.HeadBranch
+	}))
+	assert.Len(t, bodies, 2)
+}
+
+func TestGithubGraphQLErrors(t *testing.T) {
+	server, _ := newFakeAPIServer(t, map[string]string{
+		"/graphql": `{"data": null, "errors": [{"message": "Could not resolve to a Repository"}]}`,
+	})
+
+	client := newTestAPIClient(t, "git@github.com:owner/repo.git", server, "secret")
+	_, err := client.GetOpenPullRequests()
+	assert.EqualError(t, err, "Could not resolve to a Repository")
+}
+
+func TestGitlabPullRequests(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/projects/group%2Fsubgroup%2Frepo/merge_requests?state=opened&order_by=updated_at&per_page=100&page=1": `[
+			{"iid": 5, "title": "Fix bug", "web_url": "https://gitlab.com/group/subgroup/repo/-/merge_requests/5",
+			 "draft": true, "source_branch": "fix", "target_branch": "main", "source_project_id": 1, "target_project_id": 1}
+		]`,
+		"/projects/group%2Fsubgroup%2Frepo/merge_requests/5": `{
+			"iid": 5, "detailed_merge_status": "not_approved", "head_pipeline": {"status": "running"}
+		}`,
+		"/projects/group%2Fsubgroup%2Frepo/merge_requests/5/approvals": `{"approved": false, "approvals_left": 1}`,
+	})
+
+	client := newTestAPIClient(t, "git@gitlab.com:group/subgroup/repo.git", server, "secret")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Len(t, pullRequests, 1)
+
+	pr := pullRequests[0]
+	assert.NoError(t, client.LoadPullRequestStatus(pr))
+	assert.Equal(t, &models.PullRequest{
+		Number:       5,
+		Title:        "Fix bug",
+		URL:          "https://gitlab.com/group/subgroup/repo/-/merge_requests/5",
+		HeadBranch:   "fix",
+		BaseBranch:   "main",
+		IsDraft:      true,
+		ReviewStatus: models.PullRequestReviewStatusReviewRequired,
+		CheckStatus:  models.PullRequestCheckStatusPending,
+	}, pr)
+
+	assert.Len(t, *requests, 3)
+	assert.Equal(t, "Bearer secret", (*requests)[0].authorization)
+}
+
+func TestGitlabGetOpenPullRequestsPaginated(t *testing.T) {
+	mergeRequestsJSON := func(from int, to int) string {
+		return "[" + strings.Join(lo.Map(lo.RangeFrom(from, to-from+1), func(iid int, _ int) string {
+			return fmt.Sprintf(`{"iid": %d, "source_project_id": 1, "target_project_id": 1}`, iid)
+		}), ",") + "]"
+	}
+
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/projects/owner%2Frepo/merge_requests?state=opened&order_by=updated_at&per_page=100&page=1": mergeRequestsJSON(1, 100),
+		"/projects/owner%2Frepo/merge_requests?state=opened&order_by=updated_at&per_page=100&page=2": mergeRequestsJSON(101, 120),
+	})
+
+	client := newTestAPIClient(t, "git@gitlab.com:owner/repo.git", server, "secret")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Len(t, pullRequests, 120)
+	assert.Equal(t, 120, pullRequests[119].Number)
+	assert.Len(t, *requests, 2)
+}
+
+func TestGiteaPullRequests(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/repos/owner/repo/pulls?state=open&sort=recentupdate&limit=50&page=1": `[
+			{"number": 3, "title": "Feature", "html_url": "https://try.gitea.io/owner/repo/pulls/3",
+			 "head": {"ref": "feature", "sha": "abc", "repo": {"full_name": "owner/repo"}},
+			 "base": {"ref": "main", "repo": {"full_name": "owner/repo"}}}
+		]`,
+		"/repos/owner/repo/pulls/3": `{"number": 3, "head": {"ref": "feature", "sha": "abc"}, "requested_reviewers": []}`,
+		"/repos/owner/repo/pulls/3/reviews": `[
+			{"state": "REQUEST_CHANGES", "user": {"login": "alice"}},
+			{"state": "COMMENT", "user": {"login": "bob"}},
+			{"state": "APPROVED", "user": {"login": "alice"}}
+		]`,
+		"/repos/owner/repo/commits/abc/status": `{"state": "success", "total_count": 2}`,
+	})
+
+	client := newTestAPIClient(t, "git@try.gitea.io:owner/repo.git", server, "secret")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Len(t, pullRequests, 1)
+
+	pr := pullRequests[0]
+	assert.NoError(t, client.LoadPullRequestStatus(pr))
+	assert.Equal(t, "feature", pr.HeadBranch)
+	assert.False(t, pr.IsCrossRepository)
+	assert.Equal(t, models.PullRequestReviewStatusApproved, pr.ReviewStatus)
+	assert.Equal(t, models.PullRequestCheckStatusSuccess, pr.CheckStatus)
+
+	assert.Equal(t, "token secret", (*requests)[0].authorization)
+}
+
+func TestBitbucketPullRequests(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/repositories/owner/repo/pullrequests?state=OPEN&sort=-updated_on&pagelen=50": `{"values": [
+			{"id": 7, "title": "Feature", "links": {"html": {"href": "https://bitbucket.org/owner/repo/pull-requests/7"}},
+			 "source": {"branch": {"name": "feature"}, "repository": {"full_name": "someone/repo"}},
+			 "destination": {"branch": {"name": "main"}, "repository": {"full_name": "owner/repo"}}}
+		]}`,
+		"/repositories/owner/repo/pullrequests/7": `{"id": 7, "participants": [
+			{"role": "REVIEWER", "state": "approved"},
+			{"role": "REVIEWER", "state": "changes_requested"},
+			{"role": "PARTICIPANT", "state": null}
+		]}`,
+		"/repositories/owner/repo/pullrequests/7/statuses": `{"values": [{"state": "SUCCESSFUL"}, {"state": "INPROGRESS"}]}`,
+	})
+
+	client := newTestAPIClient(t, "git@bitbucket.org:owner/repo.git", server, "user:app-password")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Len(t, pullRequests, 1)
+
+	pr := pullRequests[0]
+	assert.NoError(t, client.LoadPullRequestStatus(pr))
+	assert.True(t, pr.IsCrossRepository)
+	assert.Equal(t, models.PullRequestReviewStatusChangesRequested, pr.ReviewStatus)
+	assert.Equal(t, models.PullRequestCheckStatusPending, pr.CheckStatus)
+
+	assert.Equal(t, "Basic dXNlcjphcHAtcGFzc3dvcmQ=", (*requests)[0].authorization)
+}
+
+func TestBitbucketGetOpenPullRequestsPaginated(t *testing.T) {
+	responses := map[string]string{}
+	server, requests := newFakeAPIServer(t, responses)
+	responses["/repositories/owner/repo/pullrequests?state=OPEN&sort=-updated_on&pagelen=50"] = `{
+		"values": [{"id": 2, "source": {"branch": {"name": "second"}}}],
+		"next": "` + server.URL + `/repositories/owner/repo/pullrequests?state=OPEN&sort=-updated_on&pagelen=50&page=2"
+	}`
+	responses["/repositories/owner/repo/pullrequests?state=OPEN&sort=-updated_on&pagelen=50&page=2"] = `{
+		"values": [{"id": 1, "source": {"branch": {"name": "first"}}}]
+	}`
+
+	client := newTestAPIClient(t, "git@bitbucket.org:owner/repo.git", server, "secret")
+	pullRequests, err := client.GetOpenPullRequests()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"second", "first"}, lo.Map(pullRequests, func(pr *models.PullRequest, _ int) string {
+		return pr.HeadBranch
+	}))
+	assert.Len(t, *requests, 2)
+}
+
+func TestAPIErrors(t *testing.T) {
+	server, _ := newFakeAPIServer(t, map[string]string{})
+
+	client := newTestAPIClient(t, "git@gitlab.com:owner/repo.git", server, "")
+	_, err := client.GetOpenPullRequests()
+	assert.EqualError(t, err, "404 Not Found: Not Found")
+}
+
+func TestNewAPIClientForUnsupportedService(t *testing.T) {
+	mgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), "git@ssh.dev.azure.com:v3/myorg/myproject/myrepo", nil)
+	_, err := mgr.NewAPIClient("secret")
+	assert.EqualError(t, err, "Talking to the API of this git service is not supported")
+}
+
+func TestNewAPIClientWithoutToken(t *testing.T) {
+	mgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), "git@github.com:owner/repo.git", nil)
+	_, err := mgr.NewAPIClient("")
+	assert.EqualError(t, err, "Talking to the API of github.com requires a token. Add one to git.pullRequests.tokens in your config, or log in with the service's command line tool")
+
+	mgr = NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), "git@gitlab.com:owner/repo.git", nil)
+	_, err = mgr.NewAPIClient("")
+	assert.NoError(t, err)
+}
+
+func TestGetServiceInfo(t *testing.T) {
+	mgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), "git@my.company.com:owner/repo.git", map[string]string{
+		"my.company.com": "gitlab:gitlab.my.company.com",
+	})
+	provider, webDomain, err := mgr.GetServiceInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, "gitlab", provider)
+	assert.Equal(t, "gitlab.my.company.com", webDomain)
+}
+
+func TestGithubAPIBaseURL(t *testing.T) {
+	assert.Equal(t, "https://api.github.com", githubAPIDef.baseURL("github.com"))
+	assert.Equal(t, "https://github.my.company.com/api/v3", githubAPIDef.baseURL("github.my.company.com"))
+
+	client := &githubAPIClient{requester: &apiRequester{baseURL: "https://github.my.company.com/api/v3"}}
+	assert.Equal(t, "https://github.my.company.com/api/graphql", client.graphqlURL())
+}
+
+func TestGithubCreatePullRequest(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/repos/owner/repo":                              `{"default_branch": "main"}`,
+		"/repos/owner/repo/pulls":                        `{"number": 42, "html_url": "https://github.com/owner/repo/pull/42"}`,
+		"/repos/owner/repo/pulls/42/requested_reviewers": `{}`,
+		"/repos/owner/repo/issues/42/labels":             `[]`,
+	})
+
+	client := newTestAPIClient(t, "git@github.com:owner/repo.git", server, "secret")
+
+	defaultBranch, err := client.GetDefaultBranch()
+	assert.NoError(t, err)
+	assert.Equal(t, "main", defaultBranch)
+
+	pr, err := client.CreatePullRequest(CreatePullRequestOpts{
+		HeadBranch: "feature",
+		BaseBranch: "main",
+		Title:      "Add feature",
+		Body:       "Details",
+		IsDraft:    true,
+		Reviewers:  []string{"alice", "org/team"},
+		Labels:     []string{"bug"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, pr.Number)
+	assert.Equal(t, "https://github.com/owner/repo/pull/42", pr.URL)
+
+	assert.Len(t, *requests, 4)
+	assert.JSONEq(t, `{"head": "feature", "base": "main", "title": "Add feature", "body": "Details", "draft": true}`, (*requests)[1].body)
+	assert.JSONEq(t, `{"reviewers": ["alice"], "team_reviewers": ["team"]}`, (*requests)[2].body)
+	assert.JSONEq(t, `{"labels": ["bug"]}`, (*requests)[3].body)
+}
+
+func TestGithubCreatePullRequestReviewersFail(t *testing.T) {
+	server, _ := newFakeAPIServer(t, map[string]string{
+		"/repos/owner/repo/pulls": `{"number": 42, "html_url": "https://github.com/owner/repo/pull/42"}`,
+	})
+
+	client := newTestAPIClient(t, "git@github.com:owner/repo.git", server, "secret")
+	pr, err := client.CreatePullRequest(CreatePullRequestOpts{
+		HeadBranch: "feature",
+		BaseBranch: "main",
+		Title:      "Add feature",
+		Reviewers:  []string{"nobody"},
+	})
+	assert.EqualError(t, err, "created pull request #42, but could not request reviewers: 404 Not Found: Not Found")
+	assert.Equal(t, 42, pr.Number)
+}
+
+func TestGithubCreatePullRequestFromFork(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/repos/owner/repo/pulls": `{"number": 42, "html_url": "https://github.com/owner/repo/pull/42"}`,
+	})
+
+	client := newTestAPIClient(t, "git@github.com:owner/repo.git", server, "secret")
+	pr, err := client.CreatePullRequest(CreatePullRequestOpts{
+		HeadBranch: "feature",
+		HeadOwner:  "contributor",
+		BaseBranch: "main",
+		Title:      "Add feature",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, pr.Number)
+
+	assert.Len(t, *requests, 1)
+	assert.JSONEq(t, `{"head": "contributor:feature", "base": "main", "title": "Add feature", "body": "", "draft": false}`, (*requests)[0].body)
+}
+
+func TestGitlabCreatePullRequestFromFork(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{})
+
+	client := newTestAPIClient(t, "git@gitlab.com:owner/repo.git", server, "secret")
+	_, err := client.CreatePullRequest(CreatePullRequestOpts{
+		HeadBranch: "feature",
+		HeadOwner:  "contributor",
+		BaseBranch: "main",
+		Title:      "Add feature",
+	})
+	assert.EqualError(t, err, "Creating GitLab merge requests from a fork isn't supported")
+	assert.Len(t, *requests, 0)
+}
+
+func TestGitlabCreatePullRequest(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{
+		"/users?username=alice":                 `[{"id": 7}]`,
+		"/projects/owner%2Frepo/merge_requests": `{"iid": 3, "web_url": "https://gitlab.com/owner/repo/-/merge_requests/3"}`,
+	})
+
+	client := newTestAPIClient(t, "git@gitlab.com:owner/repo.git", server, "secret")
+	pr, err := client.CreatePullRequest(CreatePullRequestOpts{
+		HeadBranch: "feature",
+		BaseBranch: "main",
+		Title:      "Add feature",
+		Body:       "Details",
+		IsDraft:    true,
+		Reviewers:  []string{"alice"},
+		Labels:     []string{"bug", "ui"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, pr.Number)
+	assert.Equal(t, "Add feature", pr.Title)
+
+	assert.Len(t, *requests, 2)
+	assert.JSONEq(t, `{
+		"source_branch": "feature", "target_branch": "main", "title": "Draft: Add feature",
+		"description": "Details", "labels": "bug,ui", "reviewer_ids": [7]
+	}`, (*requests)[1].body)
+}
+
+func TestBitbucketCreatePullRequestWithLabels(t *testing.T) {
+	server, requests := newFakeAPIServer(t, map[string]string{})
+
+	client := newTestAPIClient(t, "git@bitbucket.org:owner/repo.git", server, "secret")
+	_, err := client.CreatePullRequest(CreatePullRequestOpts{
+		HeadBranch: "feature",
+		BaseBranch: "main",
+		Title:      "Add feature",
+		Labels:     []string{"bug"},
+	})
+	assert.EqualError(t, err, "Bitbucket pull requests don't have labels")
+	assert.Len(t, *requests, 0)
+}
@@ -0,0 +1,209 @@
+package hosting_service
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+var bitbucketAPIDef = &apiDefinition{
+	baseURL: func(webDomain string) string {
+		return "https://api.bitbucket.org/2.0"
+	},
+	authorize: func(req *http.Request, token string) {
+		// App passwords are given as "username:password" and need basic
+		// auth; access tokens are bearer tokens
+		if username, password, found := strings.Cut(token, ":"); found {
+			req.SetBasicAuth(username, password)
+		} else {
+			bearerAuthorization(req, token)
+		}
+	},
+	newClient: func(requester *apiRequester, owner string, repo string) apiClient {
+		return &bitbucketAPIClient{requester: requester, owner: owner, repo: repo}
+	},
+}
+
+type bitbucketAPIClient struct {
+	requester *apiRequester
+	owner     string
+	repo      string
+}
+
+type bitbucketEndpoint struct {
+	Branch struct {
+		Name string `json:"name"`
+	} `json:"branch"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+type bitbucketPullRequest struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	Draft bool   `json:"draft"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+	Source       bitbucketEndpoint      `json:"source"`
+	Destination  bitbucketEndpoint      `json:"destination"`
+	Participants []bitbucketParticipant `json:"participants"`
+}
+
+type bitbucketParticipant struct {
+	Role string `json:"role"`
+	// "approved", "changes_requested", or empty
+	State string `json:"state"`
+}
+
+type bitbucketCommitStatus struct {
+	State string `json:"state"`
+}
+
+func (self *bitbucketAPIClient) getOpenPullRequests() ([]*models.PullRequest, error) {
+	pullRequests := []bitbucketPullRequest{}
+	// Each page has the full URL of the next one, if there is one
+	path := self.repoURL("/pullrequests?state=OPEN&sort=-updated_on&pagelen=50")
+	for path != "" {
+		var page struct {
+			Values []bitbucketPullRequest `json:"values"`
+			Next   string                 `json:"next"`
+		}
+		if err := self.requester.get(path, &page); err != nil {
+			return nil, err
+		}
+
+		pullRequests = append(pullRequests, page.Values...)
+		path = page.Next
+	}
+
+	result := make([]*models.PullRequest, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		result = append(result, &models.PullRequest{
+			Number:            pr.ID,
+			Title:             pr.Title,
+			URL:               pr.Links.HTML.Href,
+			HeadBranch:        pr.Source.Branch.Name,
+			BaseBranch:        pr.Destination.Branch.Name,
+			IsCrossRepository: pr.Source.Repository.FullName != pr.Destination.Repository.FullName,
+			IsDraft:           pr.Draft,
+		})
+	}
+
+	return result, nil
+}
+
+func (self *bitbucketAPIClient) loadPullRequestStatus(pr *models.PullRequest) error {
+	var details bitbucketPullRequest
+	if err := self.requester.get(self.repoURL(fmt.Sprintf("/pullrequests/%d", pr.Number)), &details); err != nil {
+		return err
+	}
+
+	reviewers := lo.Filter(details.Participants, func(p bitbucketParticipant, _ int) bool {
+		return p.Role == "REVIEWER"
+	})
+	states := lo.Map(reviewers, func(p bitbucketParticipant, _ int) string {
+		return p.State
+	})
+
+	switch {
+	case lo.Contains(states, "changes_requested"):
+		pr.ReviewStatus = models.PullRequestReviewStatusChangesRequested
+	case len(states) > 0 && lo.EveryBy(states, func(state string) bool { return state == "approved" }):
+		pr.ReviewStatus = models.PullRequestReviewStatusApproved
+	case len(states) > 0:
+		pr.ReviewStatus = models.PullRequestReviewStatusReviewRequired
+	default:
+		pr.ReviewStatus = models.PullRequestReviewStatusNone
+	}
+
+	var statuses struct {
+		Values []bitbucketCommitStatus `json:"values"`
+	}
+	if err := self.requester.get(self.repoURL(fmt.Sprintf("/pullrequests/%d/statuses", pr.Number)), &statuses); err != nil {
+		return err
+	}
+
+	pr.CheckStatus = combineCheckStatuses(lo.Map(statuses.Values, func(status bitbucketCommitStatus, _ int) models.PullRequestCheckStatus {
+		return bitbucketCheckStatus(status.State)
+	}))
+
+	return nil
+}
+
+func (self *bitbucketAPIClient) getDefaultBranch() (string, error) {
+	var repo struct {
+		MainBranch struct {
+			Name string `json:"name"`
+		} `json:"mainbranch"`
+	}
+	if err := self.requester.get(self.repoURL(""), &repo); err != nil {
+		return "", err
+	}
+
+	return repo.MainBranch.Name, nil
+}
+
+func (self *bitbucketAPIClient) createPullRequest(opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	if len(opts.Labels) > 0 {
+		return nil, errors.New("Bitbucket pull requests don't have labels")
+	}
+	if opts.HeadOwner != "" {
+		return nil, errors.New("Creating Bitbucket pull requests from a fork isn't supported")
+	}
+
+	// Bitbucket doesn't identify users by username; reviewers need to be
+	// given as account IDs or as UUIDs (which are in braces)
+	reviewers := lo.Map(opts.Reviewers, func(reviewer string, _ int) map[string]string {
+		if strings.HasPrefix(reviewer, "{") {
+			return map[string]string{"uuid": reviewer}
+		}
+		return map[string]string{"account_id": reviewer}
+	})
+
+	var created bitbucketPullRequest
+	body := map[string]any{
+		"title":       opts.Title,
+		"description": opts.Body,
+		"source":      map[string]any{"branch": map[string]string{"name": opts.HeadBranch}},
+		"destination": map[string]any{"branch": map[string]string{"name": opts.BaseBranch}},
+		"draft":       opts.IsDraft,
+		"reviewers":   reviewers,
+	}
+	if err := self.requester.post(self.repoURL("/pullrequests"), body, &created); err != nil {
+		return nil, err
+	}
+
+	return &models.PullRequest{
+		Number:     created.ID,
+		Title:      opts.Title,
+		URL:        created.Links.HTML.Href,
+		HeadBranch: opts.HeadBranch,
+		BaseBranch: opts.BaseBranch,
+		IsDraft:    opts.IsDraft,
+	}, nil
+}
+
+func (self *bitbucketAPIClient) repoURL(path string) string {
+	return fmt.Sprintf("/repositories/%s/%s%s", self.owner, self.repo, path)
+}
+
+func bitbucketCheckStatus(state string) models.PullRequestCheckStatus {
+	switch state {
+	case "SUCCESSFUL":
+		return models.PullRequestCheckStatusSuccess
+	case "FAILED", "STOPPED":
+		return models.PullRequestCheckStatusFailure
+	case "INPROGRESS":
+		return models.PullRequestCheckStatusPending
+	default:
+		return models.PullRequestCheckStatusNone
+	}
+}
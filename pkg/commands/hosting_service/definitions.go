@@ -15,8 +15,10 @@ var githubServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}?expand=1",
 	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}?expand=1",
 	commitURL:                       "/commit/{{.CommitHash}}",
+	pullRequestHeadRef:              "refs/pull/{{.Number}}/head",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	api:                             githubAPIDef,
 }
 
 var bitbucketServiceDef = ServiceDefinition{
@@ -29,6 +31,7 @@ var bitbucketServiceDef = ServiceDefinition{
 		`^.*@.*:(?P<owner>.*)/(?P<repo>.*?)(?:\.git)?$`,
 	},
 	repoURLTemplate: defaultRepoURLTemplate,
+	api:             bitbucketAPIDef,
 }
 
 var gitLabServiceDef = ServiceDefinition{
@@ -36,8 +39,10 @@ var gitLabServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/-/merge_requests/new?merge_request[source_branch]={{.From}}",
 	pullRequestURLIntoTargetBranch:  "/-/merge_requests/new?merge_request[source_branch]={{.From}}&merge_request[target_branch]={{.To}}",
 	commitURL:                       "/-/commit/{{.CommitHash}}",
+	pullRequestHeadRef:              "refs/merge-requests/{{.Number}}/head",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	api:                             gitlabAPIDef,
 }
 
 var azdoServiceDef = ServiceDefinition{
@@ -58,6 +63,7 @@ var bitbucketServerServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/pull-requests?create&sourceBranch={{.From}}",
 	pullRequestURLIntoTargetBranch:  "/pull-requests?create&targetBranch={{.To}}&sourceBranch={{.From}}",
 	commitURL:                       "/commits/{{.CommitHash}}",
+	pullRequestHeadRef:              "refs/pull-requests/{{.Number}}/from",
 	regexStrings: []string{
 		`^ssh://git@.*/(?P<project>.*)/(?P<repo>.*?)(?:\.git)?$`,
 		`^https://.*/scm/(?P<project>.*)/(?P<repo>.*?)(?:\.git)?$`,
@@ -70,8 +76,10 @@ var giteaServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}",
 	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}",
 	commitURL:                       "/commit/{{.CommitHash}}",
+	pullRequestHeadRef:              "refs/pull/{{.Number}}/head",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	api:                             giteaAPIDef,
 }
 
 var serviceDefinitions = []ServiceDefinition{
@@ -0,0 +1,209 @@
+package hosting_service
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+var giteaAPIDef = &apiDefinition{
+	baseURL: func(webDomain string) string {
+		return fmt.Sprintf("https://%s/api/v1", webDomain)
+	},
+	authorize: func(req *http.Request, token string) {
+		req.Header.Set("Authorization", "token "+token)
+	},
+	newClient: func(requester *apiRequester, owner string, repo string) apiClient {
+		return &giteaAPIClient{requester: requester, owner: owner, repo: repo}
+	},
+}
+
+type giteaAPIClient struct {
+	requester *apiRequester
+	owner     string
+	repo      string
+}
+
+type giteaBranchRef struct {
+	Ref  string `json:"ref"`
+	Sha  string `json:"sha"`
+	Repo *struct {
+		FullName string `json:"full_name"`
+	} `json:"repo"`
+}
+
+type giteaPullRequest struct {
+	Number             int            `json:"number"`
+	Title              string         `json:"title"`
+	HTMLURL            string         `json:"html_url"`
+	Draft              bool           `json:"draft"`
+	Head               giteaBranchRef `json:"head"`
+	Base               giteaBranchRef `json:"base"`
+	RequestedReviewers []any          `json:"requested_reviewers"`
+}
+
+// The maximum that Gitea allows by default
+const giteaPageSize = 50
+
+func (self *giteaAPIClient) getOpenPullRequests() ([]*models.PullRequest, error) {
+	pullRequests := []giteaPullRequest{}
+	// A page that isn't full is the last one
+	for page := 1; ; page++ {
+		var pagePullRequests []giteaPullRequest
+		path := fmt.Sprintf("/pulls?state=open&sort=recentupdate&limit=%d&page=%d", giteaPageSize, page)
+		if err := self.requester.get(self.repoURL(path), &pagePullRequests); err != nil {
+			return nil, err
+		}
+
+		pullRequests = append(pullRequests, pagePullRequests...)
+		if len(pagePullRequests) < giteaPageSize {
+			break
+		}
+	}
+
+	result := make([]*models.PullRequest, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		isCrossRepository := pr.Head.Repo != nil && pr.Base.Repo != nil && pr.Head.Repo.FullName != pr.Base.Repo.FullName
+		result = append(result, &models.PullRequest{
+			Number:            pr.Number,
+			Title:             pr.Title,
+			URL:               pr.HTMLURL,
+			HeadBranch:        pr.Head.Ref,
+			BaseBranch:        pr.Base.Ref,
+			IsCrossRepository: isCrossRepository,
+			// Older Gitea versions don't have a draft flag, but treat a "WIP:"
+			// prefix as such
+			IsDraft: pr.Draft || strings.HasPrefix(strings.ToUpper(pr.Title), "WIP:"),
+		})
+	}
+
+	return result, nil
+}
+
+func (self *giteaAPIClient) loadPullRequestStatus(pr *models.PullRequest) error {
+	var details giteaPullRequest
+	if err := self.requester.get(self.repoURL(fmt.Sprintf("/pulls/%d", pr.Number)), &details); err != nil {
+		return err
+	}
+
+	var reviews []struct {
+		State string `json:"state"`
+		User  struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}
+	if err := self.requester.get(self.repoURL(fmt.Sprintf("/pulls/%d/reviews", pr.Number)), &reviews); err != nil {
+		return err
+	}
+
+	// Only the latest review of each reviewer counts
+	latestStates := map[string]string{}
+	for _, review := range reviews {
+		if review.State == "APPROVED" || review.State == "REQUEST_CHANGES" {
+			latestStates[review.User.Login] = review.State
+		}
+	}
+	states := lo.Values(latestStates)
+
+	switch {
+	case lo.Contains(states, "REQUEST_CHANGES"):
+		pr.ReviewStatus = models.PullRequestReviewStatusChangesRequested
+	case len(details.RequestedReviewers) > 0:
+		pr.ReviewStatus = models.PullRequestReviewStatusReviewRequired
+	case lo.Contains(states, "APPROVED"):
+		pr.ReviewStatus = models.PullRequestReviewStatusApproved
+	default:
+		pr.ReviewStatus = models.PullRequestReviewStatusNone
+	}
+
+	var combinedStatus struct {
+		State      string `json:"state"`
+		TotalCount int    `json:"total_count"`
+	}
+	if err := self.requester.get(self.repoURL("/commits/"+details.Head.Sha+"/status"), &combinedStatus); err != nil {
+		return err
+	}
+
+	pr.CheckStatus = models.PullRequestCheckStatusNone
+	if combinedStatus.TotalCount > 0 {
+		pr.CheckStatus = giteaCheckStatus(combinedStatus.State)
+	}
+
+	return nil
+}
+
+func (self *giteaAPIClient) getDefaultBranch() (string, error) {
+	var repo struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := self.requester.get(self.repoURL(""), &repo); err != nil {
+		return "", err
+	}
+
+	return repo.DefaultBranch, nil
+}
+
+func (self *giteaAPIClient) createPullRequest(opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	// Gitea has no draft flag that can be set through the API, but treats a
+	// "WIP:" prefix as such
+	title := opts.Title
+	if opts.IsDraft {
+		title = "WIP: " + title
+	}
+
+	var created giteaPullRequest
+	body := map[string]any{
+		"head":  opts.QualifiedHeadBranch(),
+		"base":  opts.BaseBranch,
+		"title": title,
+		"body":  opts.Body,
+	}
+	if err := self.requester.post(self.repoURL("/pulls"), body, &created); err != nil {
+		return nil, err
+	}
+
+	pr := &models.PullRequest{
+		Number:     created.Number,
+		Title:      opts.Title,
+		URL:        created.HTMLURL,
+		HeadBranch: opts.HeadBranch,
+		BaseBranch: opts.BaseBranch,
+		IsDraft:    opts.IsDraft,
+	}
+
+	if len(opts.Reviewers) > 0 {
+		body := map[string]any{"reviewers": opts.Reviewers}
+		if err := self.requester.post(self.repoURL(fmt.Sprintf("/pulls/%d/requested_reviewers", pr.Number)), body, nil); err != nil {
+			return pr, pullRequestCreatedButError(pr, "request reviewers", err)
+		}
+	}
+
+	if len(opts.Labels) > 0 {
+		body := map[string]any{"labels": opts.Labels}
+		if err := self.requester.post(self.repoURL(fmt.Sprintf("/issues/%d/labels", pr.Number)), body, nil); err != nil {
+			return pr, pullRequestCreatedButError(pr, "add labels", err)
+		}
+	}
+
+	return pr, nil
+}
+
+func (self *giteaAPIClient) repoURL(path string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", self.owner, self.repo, path)
+}
+
+func giteaCheckStatus(state string) models.PullRequestCheckStatus {
+	switch state {
+	case "success", "warning":
+		return models.PullRequestCheckStatusSuccess
+	case "failure", "error":
+		return models.PullRequestCheckStatusFailure
+	case "pending":
+		return models.PullRequestCheckStatusPending
+	default:
+		return models.PullRequestCheckStatusNone
+	}
+}
@@ -0,0 +1,250 @@
+package hosting_service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+var githubAPIDef = &apiDefinition{
+	baseURL: func(webDomain string) string {
+		if webDomain == "github.com" {
+			return "https://api.github.com"
+		}
+		// GitHub Enterprise Server
+		return fmt.Sprintf("https://%s/api/v3", webDomain)
+	},
+	authorize: bearerAuthorization,
+	// The GraphQL API doesn't allow anonymous requests
+	requiresToken: true,
+	newClient: func(requester *apiRequester, owner string, repo string) apiClient {
+		return &githubAPIClient{requester: requester, owner: owner, repo: repo}
+	},
+}
+
+type githubAPIClient struct {
+	requester *apiRequester
+	owner     string
+	repo      string
+}
+
+// The REST API doesn't tell us the review decision or the combined check
+// status of a pull request, so we use GraphQL to get everything in one go
+const githubPullRequestsQuery = `query($owner: String!, $repo: String!, $cursor: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequests(states: OPEN, first: 100, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC}) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        number
+        title
+        url
+        isDraft
+        isCrossRepository
+        headRefName
+        baseRefName
+        reviewDecision
+        commits(last: 1) {
+          nodes {
+            commit {
+              statusCheckRollup {
+                state
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+type githubPullRequestNode struct {
+	Number            int    `json:"number"`
+	Title             string `json:"title"`
+	URL               string `json:"url"`
+	IsDraft           bool   `json:"isDraft"`
+	IsCrossRepository bool   `json:"isCrossRepository"`
+	HeadRefName       string `json:"headRefName"`
+	BaseRefName       string `json:"baseRefName"`
+	ReviewDecision    string `json:"reviewDecision"`
+	Commits           struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					State string `json:"state"`
+				} `json:"statusCheckRollup"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+func (self *githubAPIClient) getOpenPullRequests() ([]*models.PullRequest, error) {
+	nodes := []githubPullRequestNode{}
+	var cursor *string
+	for {
+		var response struct {
+			Data struct {
+				Repository struct {
+					PullRequests struct {
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+						Nodes []githubPullRequestNode `json:"nodes"`
+					} `json:"pullRequests"`
+				} `json:"repository"`
+			} `json:"data"`
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+
+		body := map[string]any{
+			"query":     githubPullRequestsQuery,
+			"variables": map[string]any{"owner": self.owner, "repo": self.repo, "cursor": cursor},
+		}
+		if err := self.requester.post(self.graphqlURL(), body, &response); err != nil {
+			return nil, err
+		}
+
+		// GraphQL errors come with a 200 status
+		if len(response.Errors) > 0 {
+			return nil, errors.New(response.Errors[0].Message)
+		}
+
+		pullRequests := response.Data.Repository.PullRequests
+		nodes = append(nodes, pullRequests.Nodes...)
+		if !pullRequests.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &pullRequests.PageInfo.EndCursor
+	}
+
+	result := make([]*models.PullRequest, 0, len(nodes))
+	for _, node := range nodes {
+		pr := &models.PullRequest{
+			Number:            node.Number,
+			Title:             node.Title,
+			URL:               node.URL,
+			HeadBranch:        node.HeadRefName,
+			BaseBranch:        node.BaseRefName,
+			IsCrossRepository: node.IsCrossRepository,
+			IsDraft:           node.IsDraft,
+			ReviewStatus:      githubReviewStatus(node.ReviewDecision),
+		}
+		if len(node.Commits.Nodes) > 0 && node.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+			pr.CheckStatus = githubCheckStatus(node.Commits.Nodes[0].Commit.StatusCheckRollup.State)
+		}
+		result = append(result, pr)
+	}
+
+	return result, nil
+}
+
+func (self *githubAPIClient) loadPullRequestStatus(pr *models.PullRequest) error {
+	// Already loaded by getOpenPullRequests
+	return nil
+}
+
+func (self *githubAPIClient) getDefaultBranch() (string, error) {
+	var repo struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := self.requester.get(self.repoURL(""), &repo); err != nil {
+		return "", err
+	}
+
+	return repo.DefaultBranch, nil
+}
+
+func (self *githubAPIClient) createPullRequest(opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	var created struct {
+		Number  int    `json:"number"`
+		HTMLURL string `json:"html_url"`
+	}
+	body := map[string]any{
+		"head":  opts.QualifiedHeadBranch(),
+		"base":  opts.BaseBranch,
+		"title": opts.Title,
+		"body":  opts.Body,
+		"draft": opts.IsDraft,
+	}
+	if err := self.requester.post(self.repoURL("/pulls"), body, &created); err != nil {
+		return nil, err
+	}
+
+	pr := &models.PullRequest{
+		Number:     created.Number,
+		Title:      opts.Title,
+		URL:        created.HTMLURL,
+		HeadBranch: opts.HeadBranch,
+		BaseBranch: opts.BaseBranch,
+		IsDraft:    opts.IsDraft,
+	}
+
+	if len(opts.Reviewers) > 0 {
+		// Teams are given as "org/team"
+		reviewers, teamReviewers := []string{}, []string{}
+		for _, reviewer := range opts.Reviewers {
+			if _, team, isTeam := strings.Cut(reviewer, "/"); isTeam {
+				teamReviewers = append(teamReviewers, team)
+			} else {
+				reviewers = append(reviewers, reviewer)
+			}
+		}
+		body := map[string]any{"reviewers": reviewers, "team_reviewers": teamReviewers}
+		if err := self.requester.post(self.repoURL(fmt.Sprintf("/pulls/%d/requested_reviewers", pr.Number)), body, nil); err != nil {
+			return pr, pullRequestCreatedButError(pr, "request reviewers", err)
+		}
+	}
+
+	if len(opts.Labels) > 0 {
+		// Pull requests are issues as far as labels are concerned
+		body := map[string]any{"labels": opts.Labels}
+		if err := self.requester.post(self.repoURL(fmt.Sprintf("/issues/%d/labels", pr.Number)), body, nil); err != nil {
+			return pr, pullRequestCreatedButError(pr, "add labels", err)
+		}
+	}
+
+	return pr, nil
+}
+
+func (self *githubAPIClient) repoURL(path string) string {
+	return fmt.Sprintf("/repos/%s/%s%s", self.owner, self.repo, path)
+}
+
+// The GraphQL endpoint isn't below the REST API's base URL on GitHub
+// Enterprise Server (it's /api/graphql rather than /api/v3/graphql)
+func (self *githubAPIClient) graphqlURL() string {
+	return strings.TrimSuffix(strings.TrimSuffix(self.requester.baseURL, "/"), "/v3") + "/graphql"
+}
+
+func githubReviewStatus(reviewDecision string) models.PullRequestReviewStatus {
+	switch reviewDecision {
+	case "APPROVED":
+		return models.PullRequestReviewStatusApproved
+	case "CHANGES_REQUESTED":
+		return models.PullRequestReviewStatusChangesRequested
+	case "REVIEW_REQUIRED":
+		return models.PullRequestReviewStatusReviewRequired
+	default:
+		return models.PullRequestReviewStatusNone
+	}
+}
+
+func githubCheckStatus(state string) models.PullRequestCheckStatus {
+	switch state {
+	case "SUCCESS":
+		return models.PullRequestCheckStatusSuccess
+	case "FAILURE", "ERROR":
+		return models.PullRequestCheckStatusFailure
+	case "PENDING", "EXPECTED":
+		return models.PullRequestCheckStatusPending
+	default:
+		return models.PullRequestCheckStatusNone
+	}
+}
@@ -0,0 +1,187 @@
+package hosting_service
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+var gitlabAPIDef = &apiDefinition{
+	baseURL: func(webDomain string) string {
+		return fmt.Sprintf("https://%s/api/v4", webDomain)
+	},
+	authorize: bearerAuthorization,
+	newClient: func(requester *apiRequester, owner string, repo string) apiClient {
+		return &gitlabAPIClient{requester: requester, projectPath: owner + "/" + repo}
+	},
+}
+
+type gitlabAPIClient struct {
+	requester *apiRequester
+	// e.g. "group/subgroup/project"
+	projectPath string
+}
+
+type gitlabMergeRequest struct {
+	IID                 int    `json:"iid"`
+	Title               string `json:"title"`
+	WebURL              string `json:"web_url"`
+	Draft               bool   `json:"draft"`
+	SourceBranch        string `json:"source_branch"`
+	TargetBranch        string `json:"target_branch"`
+	SourceProjectID     int    `json:"source_project_id"`
+	TargetProjectID     int    `json:"target_project_id"`
+	DetailedMergeStatus string `json:"detailed_merge_status"`
+	HeadPipeline        *struct {
+		Status string `json:"status"`
+	} `json:"head_pipeline"`
+}
+
+const gitlabPageSize = 100
+
+func (self *gitlabAPIClient) getOpenPullRequests() ([]*models.PullRequest, error) {
+	mergeRequests := []gitlabMergeRequest{}
+	// A page that isn't full is the last one
+	for page := 1; ; page++ {
+		var pageMergeRequests []gitlabMergeRequest
+		path := fmt.Sprintf("/merge_requests?state=opened&order_by=updated_at&per_page=%d&page=%d", gitlabPageSize, page)
+		if err := self.requester.get(self.projectURL(path), &pageMergeRequests); err != nil {
+			return nil, err
+		}
+
+		mergeRequests = append(mergeRequests, pageMergeRequests...)
+		if len(pageMergeRequests) < gitlabPageSize {
+			break
+		}
+	}
+
+	result := make([]*models.PullRequest, 0, len(mergeRequests))
+	for _, mr := range mergeRequests {
+		result = append(result, &models.PullRequest{
+			Number:            mr.IID,
+			Title:             mr.Title,
+			URL:               mr.WebURL,
+			HeadBranch:        mr.SourceBranch,
+			BaseBranch:        mr.TargetBranch,
+			IsCrossRepository: mr.SourceProjectID != mr.TargetProjectID,
+			IsDraft:           mr.Draft,
+		})
+	}
+
+	return result, nil
+}
+
+// The list of merge requests includes neither the pipeline nor the
+// approvals, so we need two more requests per merge request
+func (self *gitlabAPIClient) loadPullRequestStatus(pr *models.PullRequest) error {
+	var mr gitlabMergeRequest
+	if err := self.requester.get(self.projectURL(fmt.Sprintf("/merge_requests/%d", pr.Number)), &mr); err != nil {
+		return err
+	}
+
+	var approvals struct {
+		Approved      bool `json:"approved"`
+		ApprovalsLeft int  `json:"approvals_left"`
+	}
+	if err := self.requester.get(self.projectURL(fmt.Sprintf("/merge_requests/%d/approvals", pr.Number)), &approvals); err != nil {
+		return err
+	}
+
+	switch {
+	case mr.DetailedMergeStatus == "requested_changes":
+		pr.ReviewStatus = models.PullRequestReviewStatusChangesRequested
+	case approvals.ApprovalsLeft > 0:
+		pr.ReviewStatus = models.PullRequestReviewStatusReviewRequired
+	case approvals.Approved:
+		pr.ReviewStatus = models.PullRequestReviewStatusApproved
+	default:
+		pr.ReviewStatus = models.PullRequestReviewStatusNone
+	}
+
+	pr.CheckStatus = models.PullRequestCheckStatusNone
+	if mr.HeadPipeline != nil {
+		pr.CheckStatus = gitlabCheckStatus(mr.HeadPipeline.Status)
+	}
+
+	return nil
+}
+
+func (self *gitlabAPIClient) getDefaultBranch() (string, error) {
+	var project struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := self.requester.get(self.projectURL(""), &project); err != nil {
+		return "", err
+	}
+
+	return project.DefaultBranch, nil
+}
+
+func (self *gitlabAPIClient) createPullRequest(opts CreatePullRequestOpts) (*models.PullRequest, error) {
+	if opts.HeadOwner != "" {
+		return nil, errors.New("Creating GitLab merge requests from a fork isn't supported")
+	}
+
+	// Reviewers have to be given by ID
+	reviewerIDs := make([]int, 0, len(opts.Reviewers))
+	for _, reviewer := range opts.Reviewers {
+		var users []struct {
+			ID int `json:"id"`
+		}
+		if err := self.requester.get("/users?username="+url.QueryEscape(reviewer), &users); err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("unknown user '%s'", reviewer)
+		}
+		reviewerIDs = append(reviewerIDs, users[0].ID)
+	}
+
+	title := opts.Title
+	if opts.IsDraft {
+		title = "Draft: " + title
+	}
+
+	var created gitlabMergeRequest
+	body := map[string]any{
+		"source_branch": opts.HeadBranch,
+		"target_branch": opts.BaseBranch,
+		"title":         title,
+		"description":   opts.Body,
+		"labels":        strings.Join(opts.Labels, ","),
+		"reviewer_ids":  reviewerIDs,
+	}
+	if err := self.requester.post(self.projectURL("/merge_requests"), body, &created); err != nil {
+		return nil, err
+	}
+
+	return &models.PullRequest{
+		Number:     created.IID,
+		Title:      opts.Title,
+		URL:        created.WebURL,
+		HeadBranch: opts.HeadBranch,
+		BaseBranch: opts.BaseBranch,
+		IsDraft:    opts.IsDraft,
+	}, nil
+}
+
+func (self *gitlabAPIClient) projectURL(path string) string {
+	return "/projects/" + url.PathEscape(self.projectPath) + path
+}
+
+func gitlabCheckStatus(pipelineStatus string) models.PullRequestCheckStatus {
+	switch pipelineStatus {
+	case "success":
+		return models.PullRequestCheckStatusSuccess
+	case "failed", "canceled":
+		return models.PullRequestCheckStatusFailure
+	case "skipped", "manual", "":
+		return models.PullRequestCheckStatusNone
+	default:
+		// created, waiting_for_resource, preparing, pending, running, scheduled
+		return models.PullRequestCheckStatusPending
+	}
+}
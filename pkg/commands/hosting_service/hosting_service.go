@@ -1,8 +1,10 @@
 package hosting_service
 
 import (
+	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
@@ -26,6 +28,9 @@ type HostingServiceMgr struct {
 
 	// see https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	configServiceDomains map[string]string
+
+	// if set, used instead of the service's API base URL; for tests
+	apiBaseURL string
 }
 
 // NewHostingServiceMgr creates new instance of PullRequest
@@ -62,6 +67,89 @@ func (self *HostingServiceMgr) GetCommitURL(commitHash string) (string, error) {
 	return pullRequestURL, nil
 }
 
+// GetPullRequestHeadRef returns the ref on the remote that points to the head
+// commit of the given pull request, e.g. refs/pull/123/head on GitHub
+func (self *HostingServiceMgr) GetPullRequestHeadRef(number int) (string, error) {
+	serviceDomain, err := self.getServiceDomain(self.remoteURL)
+	if err != nil {
+		return "", err
+	}
+
+	template := serviceDomain.serviceDefinition.pullRequestHeadRef
+	if template == "" {
+		return "", errors.New(self.tr.UnsupportedPullRequestCheckout)
+	}
+
+	return utils.ResolvePlaceholderString(template, map[string]string{"Number": strconv.Itoa(number)}), nil
+}
+
+// GetServiceInfo returns the provider (e.g. "github") and the web domain of
+// the repo's hosting service
+func (self *HostingServiceMgr) GetServiceInfo() (string, string, error) {
+	serviceDomain, err := self.getServiceDomain(self.remoteURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	return serviceDomain.serviceDefinition.provider, serviceDomain.webDomain, nil
+}
+
+// GetRepoOwner returns the user or organization that the repo belongs to
+func (self *HostingServiceMgr) GetRepoOwner() (string, error) {
+	serviceDomain, err := self.getServiceDomain(self.remoteURL)
+	if err != nil {
+		return "", err
+	}
+
+	repoInfo, err := serviceDomain.serviceDefinition.getRepoInfoFromRemoteURL(self.remoteURL)
+	if err != nil {
+		return "", err
+	}
+
+	return repoInfo["owner"], nil
+}
+
+// NewAPIClient returns a client for the API of the repo's hosting service. The
+// token may be empty, in which case only public repos can be accessed, and
+// only with services that allow anonymous API requests at all.
+func (self *HostingServiceMgr) NewAPIClient(token string) (*APIClient, error) {
+	serviceDomain, err := self.getServiceDomain(self.remoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	api := serviceDomain.serviceDefinition.api
+	if api == nil {
+		return nil, errors.New(self.tr.UnsupportedGitServiceAPI)
+	}
+
+	if token == "" && api.requiresToken {
+		return nil, errors.New(utils.ResolvePlaceholderString(
+			self.tr.GitServiceAPITokenRequired,
+			map[string]string{"domain": serviceDomain.webDomain},
+		))
+	}
+
+	repoInfo, err := serviceDomain.serviceDefinition.getRepoInfoFromRemoteURL(self.remoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := self.apiBaseURL
+	if baseURL == "" {
+		baseURL = api.baseURL(serviceDomain.webDomain)
+	}
+
+	requester := &apiRequester{
+		httpClient: &http.Client{Timeout: apiRequestTimeout},
+		baseURL:    baseURL,
+		token:      token,
+		authorize:  api.authorize,
+	}
+
+	return &APIClient{client: api.newClient(requester, repoInfo["owner"], repoInfo["repo"])}, nil
+}
+
 func (self *HostingServiceMgr) getService() (*Service, error) {
 	serviceDomain, err := self.getServiceDomain(self.remoteURL)
 	if err != nil {
@@ -142,23 +230,40 @@ type ServiceDefinition struct {
 	pullRequestURLIntoDefaultBranch string
 	pullRequestURLIntoTargetBranch  string
 	commitURL                       string
-	regexStrings                    []string
+	// The ref that the remote keeps the head of a pull request in; empty if
+	// the service doesn't expose one (e.g. Bitbucket Cloud)
+	pullRequestHeadRef string
+	regexStrings       []string
 
 	// can expect 'webdomain' to be passed in. Otherwise, you get to pick what we match in the regex
 	repoURLTemplate string
+
+	// nil if we don't support the service's API. Expects the regex to match
+	// 'owner' and 'repo'.
+	api *apiDefinition
 }
 
 func (self ServiceDefinition) getRepoURLFromRemoteURL(url string, webDomain string) (string, error) {
+	input, err := self.getRepoInfoFromRemoteURL(url)
+	if err != nil {
+		return "", err
+	}
+
+	input["webDomain"] = webDomain
+	return utils.ResolvePlaceholderString(self.repoURLTemplate, input), nil
+}
+
+// Returns the named matches of the first regex that matches the url
+func (self ServiceDefinition) getRepoInfoFromRemoteURL(url string) (map[string]string, error) {
 	for _, regexStr := range self.regexStrings {
 		re := regexp.MustCompile(regexStr)
 		input := utils.FindNamedMatches(re, url)
 		if input != nil {
-			input["webDomain"] = webDomain
-			return utils.ResolvePlaceholderString(self.repoURLTemplate, input), nil
+			return input, nil
 		}
 	}
 
-	return "", errors.New("Failed to parse repo information from url")
+	return nil, errors.New("Failed to parse repo information from url")
 }
 
 type Service struct {
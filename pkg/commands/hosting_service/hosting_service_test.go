@@ -435,3 +435,101 @@ func TestGetPullRequestURL(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPullRequestHeadRef(t *testing.T) {
+	scenarios := []struct {
+		testName             string
+		remoteUrl            string
+		configServiceDomains map[string]string
+		expectedRef          string
+		expectedErr          string
+	}{
+		{
+			testName:    "GitHub",
+			remoteUrl:   "git@github.com:peter/calculator.git",
+			expectedRef: "refs/pull/42/head",
+		},
+		{
+			testName:    "GitLab",
+			remoteUrl:   "https://gitlab.com/me/public/repo.git",
+			expectedRef: "refs/merge-requests/42/head",
+		},
+		{
+			testName:    "Gitea on a custom domain",
+			remoteUrl:   "git@gitea.example.com:peter/calculator.git",
+			expectedRef: "refs/pull/42/head",
+			configServiceDomains: map[string]string{
+				"gitea.example.com": "gitea:gitea.example.com",
+			},
+		},
+		{
+			testName:    "Bitbucket Server",
+			remoteUrl:   "ssh://git@mycompany.bitbucket.com/project/repo.git",
+			expectedRef: "refs/pull-requests/42/from",
+			configServiceDomains: map[string]string{
+				"mycompany.bitbucket.com": "bitbucketServer:mycompany.bitbucket.com",
+			},
+		},
+		{
+			testName:    "Bitbucket doesn't expose pull request refs",
+			remoteUrl:   "git@bitbucket.org:johndoe/social_network.git",
+			expectedErr: "Checking out pull requests by number is not supported for this git service",
+		},
+		{
+			testName:    "Unknown service",
+			remoteUrl:   "git@example.com:peter/calculator.git",
+			expectedErr: "Unsupported git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			hostingServiceMgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), s.remoteUrl, s.configServiceDomains)
+			ref, err := hostingServiceMgr.GetPullRequestHeadRef(42)
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedRef, ref)
+			}
+		})
+	}
+}
+
+func TestGetRepoOwner(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		remoteUrl     string
+		expectedOwner string
+		expectedErr   string
+	}{
+		{
+			testName:      "SSH url",
+			remoteUrl:     "git@github.com:peter/calculator.git",
+			expectedOwner: "peter",
+		},
+		{
+			testName:      "HTTPS url",
+			remoteUrl:     "https://github.com/peter/calculator.git",
+			expectedOwner: "peter",
+		},
+		{
+			testName:    "Unknown service",
+			remoteUrl:   "git@example.com:peter/calculator.git",
+			expectedErr: "Unsupported git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			hostingServiceMgr := NewHostingServiceMgr(&fakes.FakeFieldLogger{}, i18n.EnglishTranslationSet(), s.remoteUrl, nil)
+			owner, err := hostingServiceMgr.GetRepoOwner()
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedOwner, owner)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The hash that git blame reports for lines that haven't been committed yet
+const NotCommittedYetHash = "0000000000000000000000000000000000000000"
+
+// BlameCommit holds the information about a commit that `git blame --porcelain`
+// prints the first time the commit appears in its output
+type BlameCommit struct {
+	Hash          string
+	AuthorName    string
+	AuthorEmail   string
+	UnixTimestamp int64
+	Summary       string
+	// The path of the file in this commit
+	Filename string
+	// The commit before this one that touched the file, and the path of the
+	// file in that commit. Empty if this is a boundary commit.
+	PreviousHash     string
+	PreviousFilename string
+	// True if this is the root commit (or the boundary of the blamed range)
+	IsBoundary bool
+}
+
+func (c *BlameCommit) IsCommitted() bool {
+	return c.Hash != NotCommittedYetHash
+}
+
+func (c *BlameCommit) ShortHash() string {
+	return utils.ShortHash(c.Hash)
+}
+
+// A single line of a blamed file
+type BlameLine struct {
+	Commit *BlameCommit
+	// line number in the blamed version of the file (1-based)
+	LineNumber int
+	// line number in the version of the file in Commit (1-based)
+	OriginalLineNumber int
+	Content            string
+	// true if this is the first line of a run of consecutive lines that were
+	// blamed on the same commit
+	IsFirstInGroup bool
+}
+
+func (l *BlameLine) ID() string {
+	return fmt.Sprintf("%d", l.LineNumber)
+}
+
+func (l *BlameLine) URN() string {
+	return "blame-line-" + l.ID()
+}
+
+func (l *BlameLine) Description() string {
+	return l.Content
+}
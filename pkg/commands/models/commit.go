@@ -41,6 +41,25 @@ const (
 	DivergenceRight
 )
 
+type SignatureStatus int
+
+// These correspond to the values of git's %G? placeholder
+const (
+	// We didn't ask git for the signature status (see git.log.showSignatures)
+	SignatureStatusUnknown SignatureStatus = iota
+	SignatureStatusNone
+	SignatureStatusGood
+	// Good signature, but we don't know whether the key can be trusted
+	SignatureStatusUntrusted
+	// Good signature that has expired, or was made by a key that has expired
+	SignatureStatusExpired
+	// Good signature made by a key that has since been revoked
+	SignatureStatusRevoked
+	SignatureStatusBad
+	// The signature can't be checked, e.g. because the key is missing
+	SignatureStatusUnverifiable
+)
+
 // Commit : A git commit
 type Commit struct {
 	Hash          string
@@ -52,7 +71,18 @@ type Commit struct {
 	AuthorName    string // something like 'Jesse Duffield'
 	AuthorEmail   string // something like 'jessedduffield@gmail.com'
 	UnixTimestamp int64
-	Divergence    Divergence // set to DivergenceNone unless we are showing the divergence view
+	// For reflog entries: when the entry was made, as opposed to UnixTimestamp
+	// which is the date of the commit the entry points to
+	ReflogTimestamp int64
+	Divergence      Divergence // set to DivergenceNone unless we are showing the divergence view
+	HasNote         bool       // whether a note is attached to the commit in the default notes ref
+
+	SignatureStatus SignatureStatus
+	Signer          string // the name of the signer, if the commit is signed
+
+	// For todos without a hash (exec, label, etc.): which of several identical
+	// todos this is, counting from the start of the git-rebase-todo file
+	TodoOccurrence int
 
 	// Hashes of parent commits (will be multiple if it's a merge commit)
 	Parents []string
@@ -103,6 +133,10 @@ func (c *Commit) IsTODO() bool {
 	return c.Action != ActionNone
 }
 
+func (c *Commit) IsSigned() bool {
+	return c.SignatureStatus != SignatureStatusUnknown && c.SignatureStatus != SignatureStatusNone
+}
+
 func IsHeadCommit(commits []*Commit, index int) bool {
 	return !commits[index].IsTODO() && (index == 0 || commits[index-1].IsTODO())
 }
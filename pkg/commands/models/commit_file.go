@@ -6,6 +6,8 @@ type CommitFile struct {
 	Name string
 
 	ChangeStatus string // e.g. 'A' for added or 'M' for modified. This is based on the result from git diff --name-status
+
+	IsLfs bool
 }
 
 func (f *CommitFile) ID() string {
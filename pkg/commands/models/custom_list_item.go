@@ -0,0 +1,27 @@
+package models
+
+import "fmt"
+
+// An item of a list that a custom command generated from its output (see the
+// outputList option of custom commands)
+type CustomListItem struct {
+	Label string
+	Value string
+	// The line of the command's output that the item was generated from
+	Line string
+	// The position of the item in the list; used as its ID because neither the
+	// label nor the value need to be unique
+	Index int
+}
+
+func (i *CustomListItem) ID() string {
+	return fmt.Sprintf("%d", i.Index)
+}
+
+func (i *CustomListItem) URN() string {
+	return "custom-list-item-" + i.ID()
+}
+
+func (i *CustomListItem) Description() string {
+	return i.Label
+}
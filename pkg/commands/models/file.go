@@ -24,6 +24,44 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// Whether the file is tracked by Git LFS according to .gitattributes
+	IsLfs bool
+	// Set if we hold an LFS lock on the file
+	LfsLock *LfsLock
+
+	// Whether git rerere has resolved the merge conflicts of the file using a
+	// previously recorded resolution. The file remains unmerged until it's
+	// staged.
+	ResolvedByRerere bool
+}
+
+// ConflictVersion is one of the versions of a file with merge conflicts;
+// its value is the number of the index stage that holds it
+type ConflictVersion int
+
+const (
+	CONFLICT_VERSION_BASE   ConflictVersion = 1
+	CONFLICT_VERSION_OURS   ConflictVersion = 2
+	CONFLICT_VERSION_THEIRS ConflictVersion = 3
+)
+
+// The versions that exist for each kind of merge conflict, e.g. for "DU"
+// (deleted by us) there is no version of ours
+var conflictVersionsByShortStatus = map[string][]ConflictVersion{
+	"UU": {CONFLICT_VERSION_BASE, CONFLICT_VERSION_OURS, CONFLICT_VERSION_THEIRS},
+	"AA": {CONFLICT_VERSION_OURS, CONFLICT_VERSION_THEIRS},
+	"DD": {CONFLICT_VERSION_BASE},
+	"AU": {CONFLICT_VERSION_OURS},
+	"UA": {CONFLICT_VERSION_THEIRS},
+	"UD": {CONFLICT_VERSION_BASE, CONFLICT_VERSION_OURS},
+	"DU": {CONFLICT_VERSION_BASE, CONFLICT_VERSION_THEIRS},
+}
+
+// Returns whether the given version of a file with merge conflicts exists. If
+// it doesn't, resolving the conflict with that version means deleting the file.
+func (f *File) HasConflictVersion(version ConflictVersion) bool {
+	return lo.Contains(conflictVersionsByShortStatus[f.ShortStatus], version)
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
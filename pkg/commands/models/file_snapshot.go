@@ -0,0 +1,33 @@
+package models
+
+// FileSnapshot records the state of the index and the working tree at some
+// point in time, as two tree objects in the object database. Untracked files
+// are included in the working tree, ignored files are not.
+type FileSnapshot struct {
+	IndexTree       string
+	WorkingTreeTree string
+	// The paths (files or directories) that the snapshot covers; restoring it
+	// leaves everything else alone. Empty if it covers the whole working tree.
+	Paths []string
+}
+
+// JournalEntry is an entry in the undo journal: a snapshot of the files taken
+// right before a destructive file-level action (like discarding changes), so
+// that the action can be undone even though it doesn't show up in the reflog.
+type JournalEntry struct {
+	// Description of the action, as shown in the command log
+	Action string
+	// The hash of HEAD at the time the snapshot was taken; we refuse to
+	// restore the snapshot if HEAD has moved since then
+	Head string
+	// The state of the files before the action was performed
+	Before *FileSnapshot
+	// The state of the files at the time the action was undone, so that the
+	// undo can be redone. Nil if the entry hasn't been undone.
+	After *FileSnapshot
+	// Unix timestamps of when the action was performed and when it was undone.
+	// These are compared against the reflog to decide whether undo/redo should
+	// act on the journal or on the reflog.
+	Timestamp       int64
+	UndoneTimestamp int64
+}
@@ -0,0 +1,16 @@
+package models
+
+// The content that git actually stores for a file tracked by Git LFS; the
+// object itself lives in LFS storage
+type LfsPointer struct {
+	Oid  string // e.g. 'sha256:4d7a2146...'
+	Size int64
+}
+
+// A lock that prevents others from pushing changes to a file tracked by Git
+// LFS
+type LfsLock struct {
+	ID    string
+	Path  string
+	Owner string
+}
@@ -0,0 +1,37 @@
+package models
+
+import "fmt"
+
+// RefsSnapshot records where the local branches and HEAD pointed at some
+// point in time
+type RefsSnapshot struct {
+	// Empty if HEAD is detached
+	CheckedOutBranch string
+	// Hash of HEAD; empty if there are no commits yet
+	Head string
+	// Hashes of all local branches, keyed by branch name
+	Branches map[string]string
+}
+
+// Operation is an entry in the operation history: a high-level action that
+// was performed in lazygit, along with the state of the branches before and
+// after it, so that it can be rolled back.
+type Operation struct {
+	// The action name, as shown in the command log
+	Action string
+	// Unix timestamp of when the action was started
+	Timestamp int64
+	Before    *RefsSnapshot
+	After     *RefsSnapshot
+	// Human-readable descriptions of what the action changed, one per line;
+	// the checkout (if any) comes first, followed by the changed branches
+	Changes []string
+}
+
+func (o *Operation) ID() string {
+	return fmt.Sprintf("%d-%s", o.Timestamp, o.Action)
+}
+
+func (o *Operation) Description() string {
+	return o.Action
+}
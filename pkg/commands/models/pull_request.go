@@ -0,0 +1,40 @@
+package models
+
+type PullRequestReviewStatus int
+
+const (
+	// No reviews were requested, or the hosting service didn't tell us
+	PullRequestReviewStatusNone PullRequestReviewStatus = iota
+	PullRequestReviewStatusReviewRequired
+	PullRequestReviewStatusApproved
+	PullRequestReviewStatusChangesRequested
+)
+
+type PullRequestCheckStatus int
+
+const (
+	// There are no CI checks for the pull request's head commit
+	PullRequestCheckStatusNone PullRequestCheckStatus = iota
+	PullRequestCheckStatusPending
+	PullRequestCheckStatusSuccess
+	PullRequestCheckStatusFailure
+)
+
+// An open pull request (or merge request, in GitLab's terms), as returned by
+// the hosting service's API
+type PullRequest struct {
+	Number int
+	Title  string
+	// Link to the pull request's web page
+	URL string
+	// The branch that is to be merged, as it's called in the repo it lives in
+	HeadBranch string
+	// The branch that the pull request is to be merged into
+	BaseBranch string
+	// True if the head branch lives in a different repo than the base branch,
+	// i.e. the pull request was created from a fork
+	IsCrossRepository bool
+	IsDraft           bool
+	ReviewStatus      PullRequestReviewStatus
+	CheckStatus       PullRequestCheckStatus
+}
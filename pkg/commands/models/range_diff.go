@@ -0,0 +1,47 @@
+package models
+
+import "fmt"
+
+type RangeDiffStatus int
+
+const (
+	// The commits of both ranges have the same patch
+	RangeDiffUnchanged RangeDiffStatus = iota
+	// The commits were matched up, but their patches differ
+	RangeDiffChanged
+	// The commit only exists in the old range
+	RangeDiffRemoved
+	// The commit only exists in the new range
+	RangeDiffAdded
+)
+
+// RangeDiffPair is one line of `git range-diff` output: a commit of the old
+// range paired up with the corresponding commit of the new range, or a commit
+// that only exists in one of them.
+type RangeDiffPair struct {
+	// The position of the commit in the old range (one-based), and its
+	// abbreviated hash. Zero and empty if the commit was added.
+	OldIndex int
+	OldHash  string
+	// The position of the commit in the new range (one-based), and its
+	// abbreviated hash. Zero and empty if the commit was removed.
+	NewIndex int
+	NewHash  string
+	Status   RangeDiffStatus
+	Summary  string
+	// The diff between the two patches, as printed by git (possibly including
+	// color codes). Empty unless Status is RangeDiffChanged.
+	Interdiff string
+}
+
+func (p *RangeDiffPair) ID() string {
+	return fmt.Sprintf("%s-%s", p.OldHash, p.NewHash)
+}
+
+func (p *RangeDiffPair) URN() string {
+	return "range-diff-pair-" + p.ID()
+}
+
+func (p *RangeDiffPair) Description() string {
+	return p.Summary
+}
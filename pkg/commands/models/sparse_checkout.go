@@ -0,0 +1,11 @@
+package models
+
+// SparseCheckout describes the sparse-checkout configuration of the current
+// worktree
+type SparseCheckout struct {
+	// In cone mode, Patterns are directories (relative to the repo root)
+	// whose contents are checked out in full. In non-cone mode they are
+	// gitignore-style patterns.
+	IsConeMode bool
+	Patterns   []string
+}
@@ -1,11 +1,30 @@
 package models
 
+import "time"
+
 // Tag : A git tag
 type Tag struct {
 	Name string
 	// this is either the first line of the message of an annotated tag, or the
 	// first line of a commit message for a lightweight tag
 	Message string
+	// the rest of the message (without the signature, if any)
+	Body        string
+	IsAnnotated bool
+	// e.g. "Jesse Duffield <jesse@example.com>"; only set for annotated tags
+	Tagger string
+	// the tagger date for annotated tags, the committer date for lightweight
+	// ones
+	Date     time.Time
+	IsSigned bool
+}
+
+func (t *Tag) FullMessage() string {
+	if t.Body == "" {
+		return t.Message
+	}
+
+	return t.Message + "\n\n" + t.Body
 }
 
 func (t *Tag) FullRefName() string {
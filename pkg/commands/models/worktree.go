@@ -1,5 +1,11 @@
 package models
 
+import (
+	"sync/atomic"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+)
+
 // A git worktree
 type Worktree struct {
 	// if false, this is a linked worktree
@@ -22,6 +28,28 @@ type Worktree struct {
 	// based on the path, but uniquified. Not the same name that git uses in the worktrees/ folder (no good reason for this,
 	// I just prefer my naming convention better)
 	Name string
+	// Loaded in the background after the worktrees themselves, so this is nil
+	// until it's available (and stays nil if the path is missing)
+	Status atomic.Pointer[WorktreeStatus]
+}
+
+// The state of a worktree's files and branch, as shown in the worktrees panel
+type WorktreeStatus struct {
+	// Number of files with staged or unstaged changes, including untracked files
+	ChangedFiles int
+	// Number of files with merge conflicts (these are also counted in ChangedFiles)
+	ConflictedFiles int
+	// Whether the worktree's branch has an upstream; Ahead and Behind are only
+	// meaningful if it does
+	HasUpstream bool
+	Ahead       int
+	Behind      int
+	// Whether the worktree is in the middle of a rebase or merge
+	WorkingTreeState enums.RebaseMode
+}
+
+func (s *WorktreeStatus) IsDirty() bool {
+	return s.ChangedFiles > 0
 }
 
 func (w *Worktree) RefName() string {
@@ -236,7 +236,9 @@ type GitConfig struct {
 	MainBranches []string `yaml:"mainBranches" jsonschema:"uniqueItems=true"`
 	// Prefix to use when skipping hooks. E.g. if set to 'WIP', then pre-commit hooks will be skipped when the commit message starts with 'WIP'
 	SkipHookPrefix string `yaml:"skipHookPrefix"`
-	// If true, periodically fetch from remote
+	// Prefix of tags that are semantic versions, e.g. 'v' for tags like v1.2.3. Used to suggest the next version when creating a release tag
+	ReleaseTagPrefix string `yaml:"releaseTagPrefix"`
+	// If true, periodically fetch from remote, and check which tags are missing on the remote of the checked out branch (shown with ↑ in the tags panel). Otherwise that is only checked when the tags panel is first focused
 	AutoFetch bool `yaml:"autoFetch"`
 	// If true, periodically refresh files and submodules
 	AutoRefresh bool `yaml:"autoRefresh"`
@@ -245,7 +247,9 @@ type GitConfig struct {
 	// If true, lazygit will automatically stage files that used to have merge
 	// conflicts but no longer do; and it will also ask you if you want to
 	// continue a merge or rebase if you've resolved all conflicts. If false, it
-	// won't do either of these things.
+	// won't do either of these things. Files that were resolved automatically
+	// by git rerere are never staged automatically, so that you can review the
+	// recorded resolution first.
 	AutoStageResolvedConflicts bool `yaml:"autoStageResolvedConflicts"`
 	// Command used when displaying the current branch git log in the main window
 	BranchLogCmd string `yaml:"branchLogCmd"`
@@ -272,6 +276,15 @@ type GitConfig struct {
 	// When copying commit hashes to the clipboard, truncate them to this
 	// length. Set to 40 to disable truncation.
 	TruncateCopiedCommitHashesTo int `yaml:"truncateCopiedCommitHashesTo"`
+	// The notes ref whose notes are shown in the commits panel, and which is
+	// edited, fetched and pushed from the notes menu, e.g. 'refs/notes/ci' or
+	// just 'ci'. If empty, git's default is used (core.notesRef, or
+	// refs/notes/commits if that isn't set either).
+	NotesRef string `yaml:"notesRef"`
+	// Config for getting pull requests from the API of the hosting service of
+	// the 'origin' remote. Supported services are GitHub, GitLab, Gitea and
+	// Bitbucket.
+	PullRequests PullRequestsConfig `yaml:"pullRequests"`
 }
 
 type PagerType string
@@ -317,6 +330,19 @@ type MergingConfig struct {
 	SquashMergeMessage string `yaml:"squashMergeMessage"`
 }
 
+type PullRequestsConfig struct {
+	// If true, show the number, review status and CI status of each branch's
+	// open pull request in the branches panel
+	ShowInBranchesPanel bool `yaml:"showInBranchesPanel"`
+	// API tokens, keyed by the domain of the hosting service (e.g.
+	// 'github.com'). For Bitbucket, use '<username>:<app password>'.
+	// If there's no token for a domain, we ask the provider's command line
+	// tool for one ('gh auth token' for GitHub, 'glab config get token' for
+	// GitLab). GitHub's API can't be used without a token at all; for the
+	// others, a token is only needed for private repos.
+	Tokens map[string]string `yaml:"tokens"`
+}
+
 type LogConfig struct {
 	// One of: 'date-order' | 'author-date-order' | 'topo-order' | 'default'
 	// 'topo-order' makes it easier to read the git log graph, but commits may not
@@ -331,6 +357,11 @@ type LogConfig struct {
 	ShowGraph string `yaml:"showGraph" jsonschema:"deprecated,enum=always,enum=never,enum=when-maximised"`
 	// displays the whole git graph by default in the commits view (equivalent to passing the `--all` argument to `git log`)
 	ShowWholeGraph bool `yaml:"showWholeGraph"`
+	// If true, verify the signature of each commit and show its status next to the
+	// commit in the commits view, and show the full signature in the main view.
+	// Verifying signatures runs gpg (or whichever program is configured for
+	// gpg.format) for every signed commit, so this can be slow in large repos.
+	ShowSignatures bool `yaml:"showSignatures"`
 }
 
 type CommitPrefixConfig struct {
@@ -420,6 +451,7 @@ type KeybindingUniversalConfig struct {
 	PrevScreenMode                    string   `yaml:"prevScreenMode"`
 	Undo                              string   `yaml:"undo"`
 	Redo                              string   `yaml:"redo"`
+	OpenOperationHistory              string   `yaml:"openOperationHistory"`
 	FilteringMenu                     string   `yaml:"filteringMenu"`
 	DiffingMenu                       string   `yaml:"diffingMenu"`
 	DiffingMenuAlt                    string   `yaml:"diffingMenu-alt"`
@@ -439,6 +471,8 @@ type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	// Add or remove directories of a sparse checkout
+	SparseCheckoutOptions string `yaml:"sparseCheckoutOptions"`
 }
 
 type KeybindingFilesConfig struct {
@@ -456,11 +490,13 @@ type KeybindingFilesConfig struct {
 	ViewResetOptions         string `yaml:"viewResetOptions"`
 	Fetch                    string `yaml:"fetch"`
 	ToggleTreeView           string `yaml:"toggleTreeView"`
-	OpenMergeTool            string `yaml:"openMergeTool"`
+	OpenMergeOptions         string `yaml:"openMergeOptions"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
 	CollapseAll              string `yaml:"collapseAll"`
 	ExpandAll                string `yaml:"expandAll"`
+	OpenBlame                string `yaml:"openBlame"`
+	ViewLfsOptions           string `yaml:"viewLfsOptions"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -476,9 +512,14 @@ type KeybindingBranchesConfig struct {
 	FastForward            string `yaml:"fastForward"`
 	CreateTag              string `yaml:"createTag"`
 	PushTag                string `yaml:"pushTag"`
+	VerifyTag              string `yaml:"verifyTag"`
+	CreateReleaseTag       string `yaml:"createReleaseTag"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
+	CheckoutPullRequest    string `yaml:"checkoutPullRequest"`
 	SortOrder              string `yaml:"sortOrder"`
+	ViewReflog             string `yaml:"viewReflog"`
+	RestoreFromReflog      string `yaml:"restoreFromReflog"`
 }
 
 type KeybindingWorktreesConfig struct {
@@ -503,13 +544,17 @@ type KeybindingCommitsConfig struct {
 	PasteCommits                   string `yaml:"pasteCommits"`
 	MarkCommitAsBaseForRebase      string `yaml:"markCommitAsBaseForRebase"`
 	CreateTag                      string `yaml:"tagCommit"`
+	CreateReleaseTag               string `yaml:"createReleaseTag"`
 	CheckoutCommit                 string `yaml:"checkoutCommit"`
 	ResetCherryPick                string `yaml:"resetCherryPick"`
 	CopyCommitAttributeToClipboard string `yaml:"copyCommitAttributeToClipboard"`
 	OpenLogMenu                    string `yaml:"openLogMenu"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
+	ViewNotesOptions               string `yaml:"viewNotesOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
+	// Insert an 'exec' todo after the selected commits, running a shell command
+	InsertExecTodo string `yaml:"insertExecTodo"`
 }
 
 type KeybindingAmendAttributeConfig struct {
@@ -525,12 +570,18 @@ type KeybindingStashConfig struct {
 
 type KeybindingCommitFilesConfig struct {
 	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	ApplyToWorkingTree string `yaml:"applyToWorkingTree"`
+	OpenBlame          string `yaml:"openBlame"`
 }
 
 type KeybindingMainConfig struct {
-	ToggleSelectHunk string `yaml:"toggleSelectHunk"`
-	PickBothHunks    string `yaml:"pickBothHunks"`
-	EditSelectHunk   string `yaml:"editSelectHunk"`
+	ToggleSelectHunk  string `yaml:"toggleSelectHunk"`
+	PickBothHunks     string `yaml:"pickBothHunks"`
+	EditSelectHunk    string `yaml:"editSelectHunk"`
+	BlameParentCommit string `yaml:"blameParentCommit"`
+	// Toggle between showing merge conflicts inline and the three-way view
+	// (ours, base and theirs side by side, with the result below)
+	ToggleThreeWayView string `yaml:"toggleThreeWayView"`
 }
 
 type KeybindingSubmodulesConfig struct {
@@ -607,8 +658,40 @@ type OSConfig struct {
 	ReadFromClipboardCmd string `yaml:"readFromClipboardCmd,omitempty"`
 }
 
+type CustomCommandOutputList struct {
+	// The regexp to run on each line of the command's output, specifying groups which are going to be kept
+	Filter string `yaml:"filter" jsonschema:"example=^(?P<id>\\d+)\\s+(?P<name>.*)$"`
+	// How to format matched groups from the filter to construct a list item's value
+	ValueFormat string `yaml:"valueFormat" jsonschema:"example={{ .id }}"`
+	// Like valueFormat but for the labels. If `labelFormat` is not specified, `valueFormat` is shown instead.
+	LabelFormat string `yaml:"labelFormat" jsonschema:"example={{ .id | yellow }} {{ .name }}"`
+}
+
+// The values allowed in the `refresh` field of a custom command's `after` hook
+var CustomCommandRefreshScopes = []string{
+	"files", "branches", "commits", "subCommits", "reflog", "stash", "tags", "remotes", "worktrees", "submodules", "status",
+}
+
 type CustomCommandAfterHook struct {
 	CheckForConflicts bool `yaml:"checkForConflicts"`
+	// The parts of the UI to refresh after the command has completed. If empty, everything is refreshed.
+	// Valid values are: files, branches, commits, subCommits, reflog, stash, tags, remotes, worktrees, submodules, status.
+	Refresh []string `yaml:"refresh" jsonschema:"uniqueItems=true,enum=files,enum=branches,enum=commits,enum=subCommits,enum=reflog,enum=stash,enum=tags,enum=remotes,enum=worktrees,enum=submodules,enum=status"`
+	// The context to focus after the command has completed (see the context field of the custom command for valid values)
+	Focus string `yaml:"focus" jsonschema:"example=localBranches"`
+	// The ref to select in the focused panel after the command has completed. Supported in the localBranches, remoteBranches and tags panels (by name), and in the commits, subCommits and reflogCommits panels (by hash).
+	// Templates have access to the command's output (with surrounding whitespace trimmed) via `{{.Output}}`.
+	SelectRef string `yaml:"selectRef" jsonschema:"example={{.Output}}"`
+	// A message to show in a toast after the command has completed. Templates have access to the command's output via `{{.Output}}`.
+	Toast string `yaml:"toast" jsonschema:"example=Created {{.Output}}"`
+	// The name of another custom command to run after this one has completed. Must not lead back to a command that has already run, which would loop forever.
+	RunCommand string `yaml:"runCommand"`
+}
+
+// Returns true if the hook does anything besides refreshing and checking for
+// conflicts
+func (h *CustomCommandAfterHook) HasActions() bool {
+	return h.Focus != "" || h.SelectRef != "" || h.Toast != "" || h.RunCommand != ""
 }
 
 type CustomCommand struct {
@@ -617,8 +700,8 @@ type CustomCommand struct {
 	// Instead of defining a single custom command, create a menu of custom commands. Useful for grouping related commands together under a single keybinding, and for keeping them out of the global keybindings menu.
 	// When using this, all other fields except Key and Description are ignored and must be empty.
 	CommandMenu []CustomCommand `yaml:"commandMenu"`
-	// The context in which to listen for the key. Valid values are: status, files, worktrees, localBranches, remotes, remoteBranches, tags, commits, reflogCommits, subCommits, commitFiles, stash, and global. Multiple contexts separated by comma are allowed; most useful for "commits, subCommits" or "files, commitFiles".
-	Context string `yaml:"context" jsonschema:"example=status,example=files,example=worktrees,example=localBranches,example=remotes,example=remoteBranches,example=tags,example=commits,example=reflogCommits,example=subCommits,example=commitFiles,example=stash,example=global"`
+	// The context in which to listen for the key. Valid values are: status, files, worktrees, localBranches, remotes, remoteBranches, tags, commits, reflogCommits, subCommits, commitFiles, stash, customList, and global. Multiple contexts separated by comma are allowed; most useful for "commits, subCommits" or "files, commitFiles".
+	Context string `yaml:"context" jsonschema:"example=status,example=files,example=worktrees,example=localBranches,example=remotes,example=remoteBranches,example=tags,example=commits,example=reflogCommits,example=subCommits,example=commitFiles,example=stash,example=customList,example=global"`
 	// The command to run (using Go template syntax for placeholder values)
 	Command string `yaml:"command" jsonschema:"example=git fetch {{.Form.Remote}} {{.Form.Branch}} && git checkout FETCH_HEAD"`
 	// If true, run the command in a subprocess (e.g. if the command requires user input)
@@ -630,17 +713,25 @@ type CustomCommand struct {
 	LoadingText string `yaml:"loadingText" jsonschema:"example=Loading..."`
 	// Label for the custom command when displayed in the keybindings menu
 	Description string `yaml:"description"`
+	// An identifier for the custom command, so that it can be run from another command's `after.runCommand`
+	Name string `yaml:"name"`
 	// If true, stream the command's output to the Command Log panel
 	// [dev] Pointer to bool so that we can distinguish unset (nil) from false.
 	Stream *bool `yaml:"stream"`
 	// If true, show the command's output in a popup within Lazygit
 	// [dev] Pointer to bool so that we can distinguish unset (nil) from false.
 	ShowOutput *bool `yaml:"showOutput"`
-	// The title to display in the popup panel if showOutput is true. If left unset, the command will be used as the title.
+	// The title to display in the popup panel if showOutput is true, or in the list panel if outputList is set. If left unset, the command will be used as the title.
 	OutputTitle string `yaml:"outputTitle"`
+	// If set, the command's output is parsed into a list that is shown in a side panel. Custom commands with context 'customList' can act on the selected items.
+	// [dev] Pointer so that we can tell whether it appears in the config file
+	OutputList *CustomCommandOutputList `yaml:"outputList"`
 	// Actions to take after the command has completed
 	// [dev] Pointer so that we can tell whether it appears in the config file
 	After *CustomCommandAfterHook `yaml:"after"`
+	// If true, the command can only be invoked when a range of items is selected
+	// in the focused panel. Use this for commands that iterate over e.g. `{{range .SelectedCommits}}`.
+	RequireRangeSelect bool `yaml:"requireRangeSelect"`
 }
 
 func (c *CustomCommand) GetDescription() string {
@@ -651,10 +742,26 @@ func (c *CustomCommand) GetDescription() string {
 	return c.Command
 }
 
+// Finds the custom command with the given name, including commands nested in
+// command menus
+func FindCustomCommandByName(customCommands []CustomCommand, name string) (CustomCommand, bool) {
+	for _, customCommand := range customCommands {
+		if customCommand.Name == name && len(customCommand.CommandMenu) == 0 {
+			return customCommand, true
+		}
+		if found, ok := FindCustomCommandByName(customCommand.CommandMenu, name); ok {
+			return found, true
+		}
+	}
+
+	return CustomCommand{}, false
+}
+
 type CustomCommandPrompt struct {
-	// One of: 'input' | 'menu' | 'confirm' | 'menuFromCommand'
+	// One of: 'input' | 'menu' | 'confirm' | 'menuFromCommand' | 'multiSelect'
 	Type string `yaml:"type"`
-	// Used to reference the entered value from within the custom command. E.g. a prompt with `key: 'Branch'` can be referred to as `{{.Form.Branch}}` in the command
+	// Used to reference the entered value from within the custom command. E.g. a prompt with `key: 'Branch'` can be referred to as `{{.Form.Branch}}` in the command.
+	// For multiSelect prompts the value is a list, e.g. `{{range .Form.Remotes}}{{.}} {{end}}`
 	Key string `yaml:"key"`
 	// The title to display in the popup panel
 	Title string `yaml:"title"`
@@ -671,20 +778,20 @@ type CustomCommandPrompt struct {
 	Body string `yaml:"body" jsonschema:"example=Are you sure you want to push to the remote?"`
 
 	// Menu options.
-	// Only for menu prompts.
+	// Only for menu and multiSelect prompts.
 	Options []CustomCommandMenuOption `yaml:"options"`
 
 	// The command to run to generate menu options
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and multiSelect prompts. For multiSelect prompts, this is used instead of `options` if set.
 	Command string `yaml:"command" jsonschema:"example=git fetch {{.Form.Remote}} {{.Form.Branch}} && git checkout FETCH_HEAD"`
 	// The regexp to run specifying groups which are going to be kept from the command's output.
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and multiSelect prompts.
 	Filter string `yaml:"filter" jsonschema:"example=.*{{.SelectedRemote.Name }}/(?P<branch>.*)"`
 	// How to format matched groups from the filter to construct a menu item's value.
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and multiSelect prompts.
 	ValueFormat string `yaml:"valueFormat" jsonschema:"example={{ .branch }}"`
 	// Like valueFormat but for the labels. If `labelFormat` is not specified, `valueFormat` is shown instead.
-	// Only for menuFromCommand prompts.
+	// Only for menuFromCommand and multiSelect prompts.
 	LabelFormat string `yaml:"labelFormat" jsonschema:"example={{ .branch | green }}"`
 }
 
@@ -795,6 +902,7 @@ func GetDefaultConfig() *UserConfig {
 				ShowWholeGraph: false,
 			},
 			SkipHookPrefix:               "WIP",
+			ReleaseTagPrefix:             "v",
 			MainBranches:                 []string{"master", "main"},
 			AutoFetch:                    true,
 			AutoRefresh:                  true,
@@ -807,6 +915,11 @@ func GetDefaultConfig() *UserConfig {
 			BranchPrefix:                 "",
 			ParseEmoji:                   false,
 			TruncateCopiedCommitHashesTo: 12,
+			NotesRef:                     "",
+			PullRequests: PullRequestsConfig{
+				ShowInBranchesPanel: false,
+				Tokens:              map[string]string(nil),
+			},
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
@@ -883,6 +996,7 @@ func GetDefaultConfig() *UserConfig {
 				PrevScreenMode:                    "_",
 				Undo:                              "z",
 				Redo:                              "<c-z>",
+				OpenOperationHistory:              "<c-g>",
 				FilteringMenu:                     "<c-s>",
 				DiffingMenu:                       "W",
 				DiffingMenuAlt:                    "<c-e>",
@@ -897,9 +1011,10 @@ func GetDefaultConfig() *UserConfig {
 				OpenDiffTool:                      "<c-t>",
 			},
 			Status: KeybindingStatusConfig{
-				CheckForUpdate:      "u",
-				RecentRepos:         "<enter>",
-				AllBranchesLogGraph: "a",
+				CheckForUpdate:        "u",
+				RecentRepos:           "<enter>",
+				AllBranchesLogGraph:   "a",
+				SparseCheckoutOptions: "S",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -915,12 +1030,14 @@ func GetDefaultConfig() *UserConfig {
 				ViewResetOptions:         "D",
 				Fetch:                    "f",
 				ToggleTreeView:           "`",
-				OpenMergeTool:            "M",
+				OpenMergeOptions:         "M",
 				OpenStatusFilter:         "<c-b>",
 				ConfirmDiscard:           "x",
 				CopyFileInfoToClipboard:  "y",
 				CollapseAll:              "-",
 				ExpandAll:                "=",
+				OpenBlame:                "b",
+				ViewLfsOptions:           "<c-l>",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -935,9 +1052,14 @@ func GetDefaultConfig() *UserConfig {
 				FastForward:            "f",
 				CreateTag:              "T",
 				PushTag:                "P",
+				VerifyTag:              "V",
+				CreateReleaseTag:       "N",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
+				CheckoutPullRequest:    "c",
 				SortOrder:              "s",
+				ViewReflog:             "<c-l>",
+				RestoreFromReflog:      "b",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
@@ -960,13 +1082,16 @@ func GetDefaultConfig() *UserConfig {
 				PasteCommits:                   "V",
 				MarkCommitAsBaseForRebase:      "B",
 				CreateTag:                      "T",
+				CreateReleaseTag:               "N",
 				CheckoutCommit:                 "<space>",
 				ResetCherryPick:                "<c-R>",
 				CopyCommitAttributeToClipboard: "y",
 				OpenLogMenu:                    "<c-l>",
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
+				ViewNotesOptions:               "<c-n>",
 				StartInteractiveRebase:         "i",
+				InsertExecTodo:                 "I",
 			},
 			AmendAttribute: KeybindingAmendAttributeConfig{
 				ResetAuthor: "a",
@@ -979,11 +1104,15 @@ func GetDefaultConfig() *UserConfig {
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
 				CheckoutCommitFile: "c",
+				ApplyToWorkingTree: "A",
+				OpenBlame:          "b",
 			},
 			Main: KeybindingMainConfig{
-				ToggleSelectHunk: "a",
-				PickBothHunks:    "b",
-				EditSelectHunk:   "E",
+				ToggleSelectHunk:   "a",
+				PickBothHunks:      "b",
+				EditSelectHunk:     "E",
+				BlameParentCommit:  "b",
+				ToggleThreeWayView: "T",
 			},
 			Submodules: KeybindingSubmodulesConfig{
 				Init:     "i",
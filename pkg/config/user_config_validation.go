@@ -92,6 +92,10 @@ func validateCustomCommandKey(key string) error {
 }
 
 func validateCustomCommands(customCommands []CustomCommand) error {
+	if err := validateCustomCommandAfterHooks(customCommands, customCommands); err != nil {
+		return err
+	}
+
 	for _, customCommand := range customCommands {
 		if err := validateCustomCommandKey(customCommand.Key); err != nil {
 			return err
@@ -106,7 +110,10 @@ func validateCustomCommands(customCommands []CustomCommand) error {
 				customCommand.Stream != nil ||
 				customCommand.ShowOutput != nil ||
 				len(customCommand.OutputTitle) > 0 ||
-				customCommand.After != nil) {
+				customCommand.OutputList != nil ||
+				len(customCommand.Name) > 0 ||
+				customCommand.After != nil ||
+				customCommand.RequireRangeSelect) {
 			commandRef := ""
 			if len(customCommand.Key) > 0 {
 				commandRef = fmt.Sprintf(" with key '%s'", customCommand.Key)
@@ -116,3 +123,60 @@ func validateCustomCommands(customCommands []CustomCommand) error {
 	}
 	return nil
 }
+
+func validateCustomCommandAfterHooks(customCommands []CustomCommand, allCustomCommands []CustomCommand) error {
+	for _, customCommand := range customCommands {
+		if err := validateCustomCommandAfterHooks(customCommand.CommandMenu, allCustomCommands); err != nil {
+			return err
+		}
+
+		if customCommand.After == nil {
+			continue
+		}
+
+		for _, scope := range customCommand.After.Refresh {
+			if err := validateEnum("customCommands.after.refresh", scope, CustomCommandRefreshScopes); err != nil {
+				return err
+			}
+		}
+
+		if customCommand.After.RunCommand != "" {
+			if _, ok := FindCustomCommandByName(allCustomCommands, customCommand.After.RunCommand); !ok {
+				return fmt.Errorf("Error with custom command '%s': after.runCommand refers to unknown custom command '%s'", customCommand.GetDescription(), customCommand.After.RunCommand)
+			}
+
+			if cycle := findAfterHookCycle(customCommand, allCustomCommands); cycle != nil {
+				return fmt.Errorf("Error with custom command '%s': after.runCommand leads to a cycle: %s", customCommand.GetDescription(), strings.Join(cycle, " -> "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Follows the chain of after.runCommand references starting at the given
+// command, and returns the names along the chain up to and including the first
+// one that repeats, or nil if the chain ends.
+func findAfterHookCycle(customCommand CustomCommand, allCustomCommands []CustomCommand) []string {
+	chain := []string{}
+	if customCommand.Name != "" {
+		chain = append(chain, customCommand.Name)
+	}
+
+	current := customCommand
+	for current.After != nil && current.After.RunCommand != "" {
+		name := current.After.RunCommand
+		if slices.Contains(chain, name) {
+			return append(chain, name)
+		}
+		chain = append(chain, name)
+
+		next, ok := FindCustomCommandByName(allCustomCommands, name)
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+
+	return nil
+}
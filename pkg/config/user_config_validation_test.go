@@ -126,6 +126,93 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "", valid: false},
 			},
 		},
+		{
+			name: "Custom command after hook refresh scopes",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Key:     "X",
+						Context: "global",
+						Command: "echo 'hello'",
+						After:   &CustomCommandAfterHook{Refresh: []string{"files", value}},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "branches", valid: true},
+				{value: "commits", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Custom command after hook runCommand",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Key:     "X",
+						Context: "global",
+						Command: "echo 'hello'",
+						After:   &CustomCommandAfterHook{RunCommand: value},
+					},
+					{
+						Key: "Y",
+						CommandMenu: []CustomCommand{
+							{Key: "1", Name: "nested", Command: "echo 'nested'", Context: "global"},
+						},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "nested", valid: true},
+				{value: "unknown", valid: false},
+			},
+		},
+		{
+			name: "Custom command after hook runCommand referring to itself",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Key:     "X",
+						Name:    "self",
+						Context: "global",
+						Command: "echo 'hello'",
+						After:   &CustomCommandAfterHook{RunCommand: value},
+					},
+					{Name: "other", Command: "echo 'other'", Context: "global"},
+				}
+			},
+			testCases: []testCase{
+				{value: "other", valid: true},
+				{value: "self", valid: false},
+			},
+		},
+		{
+			name: "Custom command after hook runCommand chain leading back to the start",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Key:     "X",
+						Name:    "a",
+						Context: "global",
+						Command: "echo 'a'",
+						After:   &CustomCommandAfterHook{RunCommand: "b"},
+					},
+					{
+						Name:    "b",
+						Context: "global",
+						Command: "echo 'b'",
+						After:   &CustomCommandAfterHook{RunCommand: value},
+					},
+					{Name: "c", Command: "echo 'c'", Context: "global"},
+				}
+			},
+			testCases: []testCase{
+				{value: "c", valid: true},
+				{value: "a", valid: false},
+				{value: "b", valid: false},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
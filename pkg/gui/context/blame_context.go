@@ -0,0 +1,101 @@
+package context
+
+import (
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameContext struct {
+	*BlameViewModel
+	*ListContextTrait
+	*SearchTrait
+}
+
+var (
+	_ types.IListContext       = (*BlameContext)(nil)
+	_ types.ISearchableContext = (*BlameContext)(nil)
+)
+
+func NewBlameContext(c *ContextCommon) *BlameContext {
+	viewModel := &BlameViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.BlameLine { return viewModel.lines },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetBlameLineListDisplayStrings(
+			viewModel.lines,
+			c.UserConfig().Gui.TimeFormat,
+			c.UserConfig().Gui.ShortTimeFormat,
+			time.Now(),
+			c.Tr,
+		)
+	}
+
+	ctx := &BlameContext{
+		BlameViewModel: viewModel,
+		SearchTrait:    NewSearchTrait(c),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().Blame,
+				WindowName: "main",
+				Key:        BLAME_CONTEXT_KEY,
+				Kind:       types.MAIN_CONTEXT,
+				Focusable:  true,
+				Transient:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+				getColumnAlignments: func() []utils.Alignment {
+					// right-align the line numbers
+					return []utils.Alignment{utils.AlignLeft, utils.AlignLeft, utils.AlignLeft, utils.AlignRight, utils.AlignLeft}
+				},
+			},
+			c: c,
+		},
+	}
+
+	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(ctx.OnSearchSelect))
+
+	return ctx
+}
+
+type BlameViewModel struct {
+	*ListViewModel[*models.BlameLine]
+
+	// path of the blamed file, relative to the repo root
+	path string
+	// the commit that the file is blamed at. Empty means the working tree.
+	commit string
+	lines  []*models.BlameLine
+}
+
+func (self *BlameViewModel) SetBlame(path string, commit string, lines []*models.BlameLine) {
+	self.path = path
+	self.commit = commit
+	self.lines = lines
+}
+
+func (self *BlameViewModel) GetPath() string {
+	return self.path
+}
+
+func (self *BlameViewModel) GetCommit() string {
+	return self.commit
+}
+
+// Selects the line with the given line number in the blamed file, or the
+// closest line if the file has fewer lines
+func (self *BlameViewModel) SelectLineNumber(lineNumber int) {
+	self.SetSelection(lineNumber - 1)
+}
+
+func (self *BlameContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+	return nil
+}
@@ -0,0 +1,121 @@
+package context
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Shows the reflog of a single branch (local or remote-tracking), as opposed
+// to the reflog of HEAD which is shown by ReflogCommitsContext.
+type BranchReflogContext struct {
+	*BranchReflogViewModel
+	*ListContextTrait
+	*DynamicTitleBuilder
+}
+
+var (
+	_ types.IListContext       = (*BranchReflogContext)(nil)
+	_ types.DiffableContext    = (*BranchReflogContext)(nil)
+	_ types.IFilterableContext = (*BranchReflogContext)(nil)
+)
+
+func NewBranchReflogContext(c *ContextCommon) *BranchReflogContext {
+	viewModel := &BranchReflogViewModel{}
+	viewModel.FilteredListViewModel = NewFilteredListViewModel(
+		func() []*models.Commit { return viewModel.commits },
+		func(commit *models.Commit) []string {
+			return []string{commit.ShortHash(), commit.Name}
+		},
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetReflogCommitListDisplayStrings(
+			viewModel.GetItems(),
+			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
+			c.Modes().CherryPicking.SelectedHashSet(),
+			c.Modes().Diffing.Ref,
+			time.Now(),
+			c.UserConfig().Gui.TimeFormat,
+			c.UserConfig().Gui.ShortTimeFormat,
+			c.UserConfig().Git.ParseEmoji,
+		)
+	}
+
+	return &BranchReflogContext{
+		BranchReflogViewModel: viewModel,
+		DynamicTitleBuilder:   NewDynamicTitleBuilder(c.Tr.BranchReflogDynamicTitle),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:                       c.Views().BranchReflog,
+				WindowName:                 "branches",
+				Key:                        BRANCH_REFLOG_CONTEXT_KEY,
+				Kind:                       types.SIDE_CONTEXT,
+				Focusable:                  true,
+				Transient:                  true,
+				NeedsRerenderOnWidthChange: types.NEEDS_RERENDER_ON_WIDTH_CHANGE_WHEN_SCREEN_MODE_CHANGES,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
+
+type BranchReflogViewModel struct {
+	*FilteredListViewModel[*models.Commit]
+
+	// the branch whose reflog is shown; either a *models.Branch or a
+	// *models.RemoteBranch
+	ref     types.Ref
+	commits []*models.Commit
+}
+
+func (self *BranchReflogViewModel) SetBranchReflog(ref types.Ref, commits []*models.Commit) {
+	self.ref = ref
+	self.commits = commits
+}
+
+func (self *BranchReflogViewModel) GetRef() types.Ref {
+	return self.ref
+}
+
+func (self *BranchReflogContext) CanRebase() bool {
+	return false
+}
+
+func (self *BranchReflogContext) GetSelectedRef() types.Ref {
+	commit := self.GetSelected()
+	if commit == nil {
+		return nil
+	}
+	return commit
+}
+
+// Returns the oldest and the newest of the selected reflog entries, or nil if
+// there is no range selection
+func (self *BranchReflogContext) GetSelectedEntryRange() (*models.Commit, *models.Commit) {
+	commits, startIdx, endIdx := self.GetSelectedItems()
+	if commits == nil || startIdx == endIdx {
+		return nil, nil
+	}
+	return commits[len(commits)-1], commits[0]
+}
+
+func (self *BranchReflogContext) GetCommits() []*models.Commit {
+	return self.getModel()
+}
+
+func (self *BranchReflogContext) GetDiffTerminals() []string {
+	itemId := self.GetSelectedItemId()
+
+	return []string{itemId}
+}
+
+func (self *BranchReflogContext) RefForAdjustingLineNumberInDiff() string {
+	return self.GetSelectedItemId()
+}
@@ -20,6 +20,10 @@ const (
 	LOCAL_COMMITS_CONTEXT_KEY            types.ContextKey = "commits"
 	REFLOG_COMMITS_CONTEXT_KEY           types.ContextKey = "reflogCommits"
 	SUB_COMMITS_CONTEXT_KEY              types.ContextKey = "subCommits"
+	RANGE_DIFF_CONTEXT_KEY               types.ContextKey = "rangeDiff"
+	OPERATION_HISTORY_CONTEXT_KEY        types.ContextKey = "operationHistory"
+	BRANCH_REFLOG_CONTEXT_KEY            types.ContextKey = "branchReflog"
+	CUSTOM_LIST_CONTEXT_KEY              types.ContextKey = "customList"
 	COMMIT_FILES_CONTEXT_KEY             types.ContextKey = "commitFiles"
 	STASH_CONTEXT_KEY                    types.ContextKey = "stash"
 	NORMAL_MAIN_CONTEXT_KEY              types.ContextKey = "normal"
@@ -29,6 +33,7 @@ const (
 	PATCH_BUILDING_MAIN_CONTEXT_KEY      types.ContextKey = "patchBuilding"
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY types.ContextKey = "patchBuildingSecondary"
 	MERGE_CONFLICTS_CONTEXT_KEY          types.ContextKey = "mergeConflicts"
+	BLAME_CONTEXT_KEY                    types.ContextKey = "blame"
 
 	// these shouldn't really be needed for anything but I'm giving them unique keys nonetheless
 	OPTIONS_CONTEXT_KEY        types.ContextKey = "options"
@@ -61,6 +66,10 @@ var AllContextKeys = []types.ContextKey{
 	LOCAL_COMMITS_CONTEXT_KEY,
 	REFLOG_COMMITS_CONTEXT_KEY,
 	SUB_COMMITS_CONTEXT_KEY,
+	RANGE_DIFF_CONTEXT_KEY,
+	OPERATION_HISTORY_CONTEXT_KEY,
+	BRANCH_REFLOG_CONTEXT_KEY,
+	CUSTOM_LIST_CONTEXT_KEY,
 	COMMIT_FILES_CONTEXT_KEY,
 	STASH_CONTEXT_KEY,
 	NORMAL_MAIN_CONTEXT_KEY,
@@ -70,6 +79,7 @@ var AllContextKeys = []types.ContextKey{
 	PATCH_BUILDING_MAIN_CONTEXT_KEY,
 	PATCH_BUILDING_SECONDARY_CONTEXT_KEY,
 	MERGE_CONFLICTS_CONTEXT_KEY,
+	BLAME_CONTEXT_KEY,
 
 	MENU_CONTEXT_KEY,
 	CONFIRMATION_CONTEXT_KEY,
@@ -96,6 +106,10 @@ type ContextTree struct {
 	RemoteBranches              *RemoteBranchesContext
 	ReflogCommits               *ReflogCommitsContext
 	SubCommits                  *SubCommitsContext
+	RangeDiff                   *RangeDiffContext
+	OperationHistory            *OperationHistoryContext
+	BranchReflog                *BranchReflogContext
+	CustomList                  *CustomListContext
 	Stash                       *StashContext
 	Suggestions                 *SuggestionsContext
 	Normal                      types.Context
@@ -105,6 +119,7 @@ type ContextTree struct {
 	CustomPatchBuilder          *PatchExplorerContext
 	CustomPatchBuilderSecondary types.Context
 	MergeConflicts              *MergeConflictsContext
+	Blame                       *BlameContext
 	Confirmation                *ConfirmationContext
 	CommitMessage               *CommitMessageContext
 	CommitDescription           types.Context
@@ -129,8 +144,14 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Snake,
 		self.Submodules,
 		self.Worktrees,
+		// must come before Files, because the last context of each window
+		// determines the view that is initially shown in it
+		self.CustomList,
 		self.Files,
 		self.SubCommits,
+		self.BranchReflog,
+		self.RangeDiff,
+		self.OperationHistory,
 		self.Remotes,
 		self.RemoteBranches,
 		self.Tags,
@@ -145,6 +166,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.CommitDescription,
 
 		self.MergeConflicts,
+		self.Blame,
 		self.StagingSecondary,
 		self.Staging,
 		self.CustomPatchBuilderSecondary,
@@ -0,0 +1,82 @@
+package context
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Shows the items that a custom command generated from its output. Other custom
+// commands can be bound to this context to act on the selected items.
+type CustomListContext struct {
+	*CustomListViewModel
+	*ListContextTrait
+	*SearchTrait
+}
+
+var (
+	_ types.IListContext       = (*CustomListContext)(nil)
+	_ types.ISearchableContext = (*CustomListContext)(nil)
+)
+
+func NewCustomListContext(c *ContextCommon) *CustomListContext {
+	viewModel := &CustomListViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.CustomListItem { return viewModel.items },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return lo.Map(viewModel.items, func(item *models.CustomListItem, _ int) []string {
+			return []string{item.Label}
+		})
+	}
+
+	ctx := &CustomListContext{
+		CustomListViewModel: viewModel,
+		SearchTrait:         NewSearchTrait(c),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().CustomList,
+				WindowName: "files",
+				Key:        CUSTOM_LIST_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+				// The list takes the place of whichever panel it was opened
+				// from, so like e.g. sub-commits it needs to be transient; it
+				// still stays in that panel's window until the user leaves it
+				Transient: true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+
+	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(ctx.OnSearchSelect))
+
+	return ctx
+}
+
+type CustomListViewModel struct {
+	*ListViewModel[*models.CustomListItem]
+
+	items []*models.CustomListItem
+	// the name of the custom command that produced the items (may be empty)
+	source string
+}
+
+func (self *CustomListViewModel) SetItems(items []*models.CustomListItem, source string) {
+	self.items = items
+	self.source = source
+}
+
+func (self *CustomListViewModel) GetSource() string {
+	return self.source
+}
+
+func (self *CustomListContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+	return nil
+}
@@ -197,6 +197,15 @@ func (self *MenuContext) OnMenuPress(selectedItem *types.MenuItem) error {
 		return nil
 	}
 
+	if selectedItem != nil && selectedItem.KeepOpen {
+		if err := selectedItem.OnPress(); err != nil {
+			return err
+		}
+
+		self.HandleRender()
+		return nil
+	}
+
 	self.c.Context().Pop()
 
 	if selectedItem == nil {
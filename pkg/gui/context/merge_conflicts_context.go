@@ -21,6 +21,10 @@ type ConflictsViewModel struct {
 	// userVerticalScrolling tells us if the user has started scrolling through the file themselves
 	// in which case we won't auto-scroll to a conflict.
 	userVerticalScrolling bool
+
+	// whether the selected conflict is shown as three side-by-side panes (ours,
+	// base and theirs) with the result below, rather than inline in the file
+	threeWay bool
 }
 
 func NewMergeConflictsContext(
@@ -68,6 +72,14 @@ func (self *MergeConflictsContext) IsUserScrolling() bool {
 	return self.viewModel.userVerticalScrolling
 }
 
+func (self *MergeConflictsContext) IsThreeWay() bool {
+	return self.viewModel.threeWay
+}
+
+func (self *MergeConflictsContext) ToggleThreeWay() {
+	self.viewModel.threeWay = !self.viewModel.threeWay
+}
+
 func (self *MergeConflictsContext) RenderAndFocus() {
 	self.setContent()
 	self.FocusSelection()
@@ -88,9 +100,18 @@ func (self *MergeConflictsContext) GetContentToRender() string {
 		return ""
 	}
 
+	if self.IsThreeWay() {
+		content, _ := self.renderThreeWay()
+		return content
+	}
+
 	return mergeconflicts.ColoredConflictFile(self.GetState())
 }
 
+func (self *MergeConflictsContext) renderThreeWay() (string, int) {
+	return mergeconflicts.RenderThreeWay(self.GetState(), self.c.Tr, self.GetView().InnerWidth())
+}
+
 func (self *MergeConflictsContext) setContent() {
 	self.GetView().SetContent(self.GetContentToRender())
 }
@@ -105,6 +126,10 @@ func (self *MergeConflictsContext) FocusSelection() {
 
 func (self *MergeConflictsContext) SetSelectedLineRange() {
 	startIdx, endIdx := self.GetState().GetSelectedRange()
+	if self.IsThreeWay() {
+		_, selectedRow := self.renderThreeWay()
+		startIdx, endIdx = selectedRow, selectedRow
+	}
 	view := self.GetView()
 	originY := view.OriginY()
 	// As far as the view is concerned, we are always selecting a range
@@ -114,6 +139,9 @@ func (self *MergeConflictsContext) SetSelectedLineRange() {
 
 func (self *MergeConflictsContext) GetOriginY() int {
 	view := self.GetView()
-	conflictMiddle := self.GetState().GetConflictMiddle()
-	return int(math.Max(0, float64(conflictMiddle-(view.InnerHeight()/2))))
+	middle := self.GetState().GetConflictMiddle()
+	if self.IsThreeWay() {
+		_, middle = self.renderThreeWay()
+	}
+	return int(math.Max(0, float64(middle-(view.InnerHeight()/2))))
 }
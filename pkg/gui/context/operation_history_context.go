@@ -0,0 +1,62 @@
+package context
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Lists the actions of the operation history, newest first; see
+// OperationHistoryHelper
+type OperationHistoryContext struct {
+	*OperationHistoryViewModel
+	*ListContextTrait
+}
+
+var _ types.IListContext = (*OperationHistoryContext)(nil)
+
+func NewOperationHistoryContext(c *ContextCommon) *OperationHistoryContext {
+	viewModel := &OperationHistoryViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.Operation { return c.Model().OperationHistory },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetOperationListDisplayStrings(c.Model().OperationHistory)
+	}
+
+	return &OperationHistoryContext{
+		OperationHistoryViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().OperationHistory,
+				WindowName: "commits",
+				Key:        OPERATION_HISTORY_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+				Transient:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+}
+
+type OperationHistoryViewModel struct {
+	*ListViewModel[*models.Operation]
+
+	// the state of the branches when the history was last loaded, which is
+	// what decides whether an operation can still be rolled back
+	currentRefs *models.RefsSnapshot
+}
+
+func (self *OperationHistoryViewModel) SetCurrentRefs(currentRefs *models.RefsSnapshot) {
+	self.currentRefs = currentRefs
+}
+
+func (self *OperationHistoryViewModel) GetCurrentRefs() *models.RefsSnapshot {
+	return self.currentRefs
+}
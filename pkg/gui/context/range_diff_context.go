@@ -0,0 +1,82 @@
+package context
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RangeDiffContext struct {
+	*RangeDiffViewModel
+	*ListContextTrait
+	*SearchTrait
+}
+
+var (
+	_ types.IListContext       = (*RangeDiffContext)(nil)
+	_ types.ISearchableContext = (*RangeDiffContext)(nil)
+)
+
+func NewRangeDiffContext(c *ContextCommon) *RangeDiffContext {
+	viewModel := &RangeDiffViewModel{}
+	viewModel.ListViewModel = NewListViewModel(
+		func() []*models.RangeDiffPair { return viewModel.pairs },
+	)
+
+	getDisplayStrings := func(_ int, _ int) [][]string {
+		return presentation.GetRangeDiffPairListDisplayStrings(viewModel.pairs)
+	}
+
+	ctx := &RangeDiffContext{
+		RangeDiffViewModel: viewModel,
+		SearchTrait:        NewSearchTrait(c),
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().RangeDiff,
+				WindowName: "commits",
+				Key:        RANGE_DIFF_CONTEXT_KEY,
+				Kind:       types.SIDE_CONTEXT,
+				Focusable:  true,
+				Transient:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+			},
+			c: c,
+		},
+	}
+
+	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(ctx.OnSearchSelect))
+
+	return ctx
+}
+
+type RangeDiffViewModel struct {
+	*ListViewModel[*models.RangeDiffPair]
+
+	// the two versions of the commit series that are being compared; each is
+	// either a range or a single ref
+	oldRange string
+	newRange string
+	pairs    []*models.RangeDiffPair
+}
+
+func (self *RangeDiffViewModel) SetRangeDiff(oldRange string, newRange string, pairs []*models.RangeDiffPair) {
+	self.oldRange = oldRange
+	self.newRange = newRange
+	self.pairs = pairs
+}
+
+func (self *RangeDiffViewModel) GetOldRange() string {
+	return self.oldRange
+}
+
+func (self *RangeDiffViewModel) GetNewRange() string {
+	return self.newRange
+}
+
+func (self *RangeDiffContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
+	return nil
+}
@@ -25,20 +25,24 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
-		Files:          NewWorkingTreeContext(c),
-		Submodules:     NewSubmodulesContext(c),
-		Menu:           NewMenuContext(c),
-		Remotes:        NewRemotesContext(c),
-		Worktrees:      NewWorktreesContext(c),
-		RemoteBranches: NewRemoteBranchesContext(c),
-		LocalCommits:   NewLocalCommitsContext(c),
-		CommitFiles:    commitFilesContext,
-		ReflogCommits:  NewReflogCommitsContext(c),
-		SubCommits:     NewSubCommitsContext(c),
-		Branches:       NewBranchesContext(c),
-		Tags:           NewTagsContext(c),
-		Stash:          NewStashContext(c),
-		Suggestions:    NewSuggestionsContext(c),
+		Files:            NewWorkingTreeContext(c),
+		Submodules:       NewSubmodulesContext(c),
+		Menu:             NewMenuContext(c),
+		Remotes:          NewRemotesContext(c),
+		Worktrees:        NewWorktreesContext(c),
+		RemoteBranches:   NewRemoteBranchesContext(c),
+		LocalCommits:     NewLocalCommitsContext(c),
+		CommitFiles:      commitFilesContext,
+		ReflogCommits:    NewReflogCommitsContext(c),
+		SubCommits:       NewSubCommitsContext(c),
+		RangeDiff:        NewRangeDiffContext(c),
+		OperationHistory: NewOperationHistoryContext(c),
+		BranchReflog:     NewBranchReflogContext(c),
+		CustomList:       NewCustomListContext(c),
+		Branches:         NewBranchesContext(c),
+		Tags:             NewTagsContext(c),
+		Stash:            NewStashContext(c),
+		Suggestions:      NewSuggestionsContext(c),
 		Normal: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:       types.MAIN_CONTEXT,
@@ -99,6 +103,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 		MergeConflicts: NewMergeConflictsContext(
 			c,
 		),
+		Blame:         NewBlameContext(c),
 		Confirmation:  NewConfirmationContext(c),
 		CommitMessage: NewCommitMessageContext(c),
 		CommitDescription: NewSimpleContext(
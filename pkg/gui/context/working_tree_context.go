@@ -31,7 +31,7 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 	getDisplayStrings := func(_ int, _ int) [][]string {
 		showFileIcons := icons.IsIconEnabled() && c.UserConfig().Gui.ShowFileIcons
 		showNumstat := c.UserConfig().Gui.ShowNumstatInFilesView
-		lines := presentation.RenderFileTree(viewModel, c.Model().Submodules, showFileIcons, showNumstat)
+		lines := presentation.RenderFileTree(viewModel, c.Model().Submodules, c.Model().SparseCheckout, showFileIcons, showNumstat)
 		return lo.Map(lines, func(line string, _ int) []string {
 			return []string{line}
 		})
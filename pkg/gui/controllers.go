@@ -27,11 +27,12 @@ func (gui *Gui) resetHelpersAndControllers() {
 	helperCommon := gui.c
 	recordDirectoryHelper := helpers.NewRecordDirectoryHelper(helperCommon)
 	reposHelper := helpers.NewRecentReposHelper(helperCommon, recordDirectoryHelper, gui.onNewRepo)
-	refsHelper := helpers.NewRefsHelper(helperCommon)
+	operationHistoryHelper := helpers.NewOperationHistoryHelper(helperCommon)
+	refsHelper := helpers.NewRefsHelper(helperCommon, operationHistoryHelper)
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon)
 	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper)
 
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper, operationHistoryHelper)
 
 	setCommitSummary := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
 	setCommitDescription := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitDescription })
@@ -59,6 +60,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 	stagingHelper := helpers.NewStagingHelper(helperCommon)
 	mergeConflictsHelper := helpers.NewMergeConflictsHelper(helperCommon)
 	searchHelper := helpers.NewSearchHelper(helperCommon)
+	hostHelper := helpers.NewHostHelper(helperCommon)
+	pullRequestsHelper := helpers.NewPullRequestsHelper(helperCommon, hostHelper, commitsHelper, suggestionsHelper)
+	upstreamHelper := helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc)
+	tagsHelper := helpers.NewTagsHelper(helperCommon, commitsHelper, upstreamHelper)
 
 	refreshHelper := helpers.NewRefreshHelper(
 		helperCommon,
@@ -69,11 +74,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsHelper,
 		worktreeHelper,
 		searchHelper,
+		pullRequestsHelper,
+		tagsHelper,
 	)
 	diffHelper := helpers.NewDiffHelper(helperCommon)
 	cherryPickHelper := helpers.NewCherryPickHelper(
 		helperCommon,
 		rebaseHelper,
+		operationHistoryHelper,
 	)
 	bisectHelper := helpers.NewBisectHelper(helperCommon)
 	windowHelper := helpers.NewWindowHelper(helperCommon, viewHelper)
@@ -97,22 +105,23 @@ func (gui *Gui) resetHelpersAndControllers() {
 
 		gui.State.Model.SubCommits = commits
 	}
+	subCommitsHelper := helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits)
 	gui.helpers = &helpers.Helpers{
 		Refs:            refsHelper,
-		Host:            helpers.NewHostHelper(helperCommon),
+		Host:            hostHelper,
 		PatchBuilding:   patchBuildingHelper,
 		Staging:         stagingHelper,
 		Bisect:          bisectHelper,
 		Suggestions:     suggestionsHelper,
 		Files:           helpers.NewFilesHelper(helperCommon),
 		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper),
-		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper),
-		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper),
+		Tags:            tagsHelper,
+		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper, operationHistoryHelper),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
 		CherryPick:      cherryPickHelper,
-		Upstream:        helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
+		Upstream:        upstreamHelper,
 		AmendHelper:     helpers.NewAmendHelper(helperCommon, gpgHelper),
 		FixupHelper:     helpers.NewFixupHelper(helperCommon),
 		Commits:         commitsHelper,
@@ -134,9 +143,13 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Search:           searchHelper,
+		Worktree:         worktreeHelper,
+		SubCommits:       subCommitsHelper,
+		Blame:            helpers.NewBlameHelper(helperCommon, subCommitsHelper),
+		Journal:          helpers.NewJournalHelper(helperCommon),
+		OperationHistory: operationHistoryHelper,
+		PullRequests:     pullRequestsHelper,
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -171,6 +184,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		common,
 	)
 	mergeConflictsController := controllers.NewMergeConflictsController(common)
+	blameController := controllers.NewBlameController(common)
 	remotesController := controllers.NewRemotesController(
 		common,
 		func(branches []*models.RemoteBranch) { gui.State.Model.RemoteBranches = branches },
@@ -193,6 +207,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 	snakeController := controllers.NewSnakeController(common)
 	reflogCommitsController := controllers.NewReflogCommitsController(common)
 	subCommitsController := controllers.NewSubCommitsController(common)
+	rangeDiffController := controllers.NewRangeDiffController(common)
+	operationHistoryController := controllers.NewOperationHistoryController(common)
+	branchReflogController := controllers.NewBranchReflogController(common)
+	customListController := controllers.NewCustomListController(common)
 	statusController := controllers.NewStatusController(common)
 	commandLogController := controllers.NewCommandLogController(common)
 	confirmationController := controllers.NewConfirmationController(common)
@@ -237,6 +255,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.CommitFiles,
 		gui.State.Contexts.SubCommits,
+		gui.State.Contexts.RangeDiff,
+		gui.State.Contexts.OperationHistory,
+		gui.State.Contexts.BranchReflog,
+		gui.State.Contexts.CustomList,
 		gui.State.Contexts.Stash,
 	} {
 		controllers.AttachControllers(context, sideWindowControllerFactory.Create(context))
@@ -267,6 +289,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.LocalCommits,
 		gui.State.Contexts.ReflogCommits,
 		gui.State.Contexts.SubCommits,
+		gui.State.Contexts.BranchReflog,
 	} {
 		controllers.AttachControllers(context, controllers.NewBasicCommitsController(common, context))
 	}
@@ -279,6 +302,22 @@ func (gui *Gui) resetHelpersAndControllers() {
 		subCommitsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.RangeDiff,
+		rangeDiffController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.OperationHistory,
+		operationHistoryController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.BranchReflog,
+		branchReflogController,
+	)
+
+	controllers.AttachControllers(gui.State.Contexts.CustomList,
+		customListController,
+	)
+
 	// TODO: add scroll controllers for main panels (need to bring some more functionality across for that e.g. reading more from the currently displayed git command)
 	controllers.AttachControllers(gui.State.Contexts.Staging,
 		stagingController,
@@ -306,6 +345,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		mergeConflictsController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.Blame,
+		blameController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Files,
 		filesController,
 	)
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -70,6 +72,14 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenCommitInBrowser,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ViewNotesOptions),
+			Handler:           self.withItem(self.createNotesMenu),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ViewNotesOptions,
+			Tooltip:           self.c.Tr.ViewNotesOptionsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.New),
 			Handler:           self.withItem(self.newBranch),
@@ -343,6 +353,127 @@ func (self *BasicCommitsController) createResetMenu(commit *models.Commit) error
 	return self.c.Helpers().Refs.CreateGitResetMenu(commit.Hash)
 }
 
+func (self *BasicCommitsController) createNotesMenu(commit *models.Commit) error {
+	note := self.c.Git().Notes.Get(commit.Hash)
+	var noNoteDisabledReason *types.DisabledReason
+	if note == "" {
+		noNoteDisabledReason = &types.DisabledReason{Text: self.c.Tr.CommitHasNoNote}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.NotesMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.EditNote,
+				OnPress: func() error {
+					return self.editNote(commit, note)
+				},
+				Key: 'e',
+			},
+			{
+				Label: self.c.Tr.EditNoteInEditor,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.EditNote)
+					return self.c.RunSubprocessAndRefresh(self.c.Git().Notes.EditCmdObj(commit.Hash))
+				},
+				Key: 'E',
+			},
+			{
+				Label:          self.c.Tr.RemoveNote,
+				DisabledReason: noNoteDisabledReason,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.RemoveNote)
+					if err := self.c.Git().Notes.Remove(commit.Hash); err != nil {
+						return err
+					}
+					return self.refreshNotes()
+				},
+				Key: 'd',
+			},
+			{
+				Label:   self.c.Tr.FetchNotes,
+				OnPress: self.fetchNotes,
+				Key:     'f',
+			},
+			{
+				Label:   self.c.Tr.PushNotes,
+				OnPress: self.pushNotes,
+				Key:     'p',
+			},
+		},
+	})
+}
+
+func (self *BasicCommitsController) editNote(commit *models.Commit, note string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.EditNoteTitle,
+		InitialContent: note,
+		HandleConfirm: func(response string) error {
+			// An empty note is meaningless, so we take it to mean that the user
+			// wants to get rid of the note altogether
+			if strings.TrimSpace(response) == "" {
+				self.c.LogAction(self.c.Tr.Actions.RemoveNote)
+				if err := self.c.Git().Notes.Remove(commit.Hash); err != nil {
+					return err
+				}
+			} else {
+				self.c.LogAction(self.c.Tr.Actions.EditNote)
+				if err := self.c.Git().Notes.Set(commit.Hash, response); err != nil {
+					return err
+				}
+			}
+
+			return self.refreshNotes()
+		},
+	})
+
+	return nil
+}
+
+func (self *BasicCommitsController) fetchNotes() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.FetchNotesTitle,
+		InitialContent:      self.c.Helpers().Upstream.GetSuggestedRemoteForCheckedOutBranch(),
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.FetchNotes)
+				if err := self.c.Git().Sync.FetchNotes(task, remoteName); err != nil {
+					return err
+				}
+				return self.refreshNotes()
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *BasicCommitsController) pushNotes() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.PushNotesTitle,
+		InitialContent:      self.c.Helpers().Upstream.GetSuggestedRemoteForCheckedOutBranch(),
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PushNotes)
+				return self.c.Git().Sync.PushNotes(task, remoteName)
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *BasicCommitsController) refreshNotes() error {
+	scope := []types.RefreshableView{types.COMMITS}
+	if self.context.GetKey() == context.SUB_COMMITS_CONTEXT_KEY {
+		scope = append(scope, types.SUB_COMMITS)
+	}
+
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: scope})
+}
+
 func (self *BasicCommitsController) checkout(commit *models.Commit) error {
 	return self.c.Helpers().Refs.CreateCheckoutMenu(commit)
 }
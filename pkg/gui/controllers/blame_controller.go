@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type BlameController struct {
+	baseController
+	*ListControllerTrait[*models.BlameLine]
+	c *ControllerCommon
+}
+
+var _ types.IController = &BlameController{}
+
+func NewBlameController(
+	c *ControllerCommon,
+) *BlameController {
+	return &BlameController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.BlameLine](
+			c,
+			c.Contexts().Blame,
+			c.Contexts().Blame.GetSelected,
+			c.Contexts().Blame.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *BlameController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.goToCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.lineIsCommitted)),
+			Description:       self.c.Tr.BlameGoToCommit,
+			Tooltip:           self.c.Tr.BlameGoToCommitTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.BlameParentCommit),
+			Handler:           self.withItem(self.blameParentCommit),
+			GetDisabledReason: self.require(self.singleItemSelected(self.lineHasEarlierHistory)),
+			Description:       self.c.Tr.BlameParentCommit,
+			Tooltip:           self.c.Tr.BlameParentCommitTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Edit),
+			Handler:           self.withItem(self.edit),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.EditFile,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Return),
+			Handler:     self.escape,
+			Description: self.c.Tr.ExitBlame,
+		},
+	}
+
+	return bindings
+}
+
+func (self *BlameController) GetOnFocus() func(types.OnFocusOpts) {
+	return func(types.OnFocusOpts) {
+		// The blame view takes up the whole main window
+		self.c.State().GetRepoState().SetSplitMainPanel(false)
+	}
+}
+
+func (self *BlameController) goToCommit(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.GoToCommit(line)
+}
+
+func (self *BlameController) blameParentCommit(line *models.BlameLine) error {
+	return self.c.Helpers().Blame.BlameParentCommit(line)
+}
+
+func (self *BlameController) edit(line *models.BlameLine) error {
+	return self.c.Helpers().Files.EditFileAtLine(self.context().GetPath(), line.LineNumber)
+}
+
+func (self *BlameController) escape() error {
+	self.c.Context().Pop()
+	return nil
+}
+
+func (self *BlameController) lineIsCommitted(line *models.BlameLine) *types.DisabledReason {
+	if !line.Commit.IsCommitted() {
+		return &types.DisabledReason{Text: self.c.Tr.LineNotCommittedYet}
+	}
+
+	return nil
+}
+
+func (self *BlameController) lineHasEarlierHistory(line *models.BlameLine) *types.DisabledReason {
+	if line.Commit.PreviousHash == "" {
+		return &types.DisabledReason{Text: self.c.Tr.LineHasNoEarlierHistory}
+	}
+
+	return nil
+}
+
+func (self *BlameController) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BranchReflogController struct {
+	baseController
+	*ListControllerTrait[*models.Commit]
+	c *ControllerCommon
+}
+
+var _ types.IController = &BranchReflogController{}
+
+func NewBranchReflogController(
+	c *ControllerCommon,
+) *BranchReflogController {
+	return &BranchReflogController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.Commit](
+			c,
+			c.Contexts().BranchReflog,
+			c.Contexts().BranchReflog.GetSelected,
+			c.Contexts().BranchReflog.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *BranchReflogController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Branches.RestoreFromReflog),
+			Handler:           self.withItem(self.restore),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canRestore)),
+			Description:       self.c.Tr.RestoreBranchToReflogEntry,
+			Tooltip:           self.c.Tr.RestoreBranchToReflogEntryTooltip,
+			DisplayOnScreen:   true,
+		},
+	}
+}
+
+func (self *BranchReflogController) Context() types.Context {
+	return self.context()
+}
+
+func (self *BranchReflogController) context() *context.BranchReflogContext {
+	return self.c.Contexts().BranchReflog
+}
+
+func (self *BranchReflogController) GetOnRenderToMain() func() {
+	return func() {
+		self.c.Helpers().Diff.WithDiffModeCheck(func() {
+			commit := self.context().GetSelected()
+			var task types.UpdateTask
+			if commit == nil {
+				task = types.NewRenderStringTask("No reflog history")
+			} else if from, to := self.context().GetSelectedEntryRange(); from != nil {
+				// Unlike for a range of commits, we want the diff between the
+				// two entries themselves, not including the changes of the
+				// oldest one, because that's what the branch looked like then
+				cmdObj := self.c.Git().Diff.DiffCmdObj([]string{from.Hash, to.Hash, "--stat", "-p", "--"})
+				ptyTask := types.NewRunPtyTask(cmdObj.GetCmd())
+				ptyTask.Prefix = style.FgYellow.Sprintf("%s %s-%s\n\n", self.c.Tr.ShowingDiffBetweenReflogEntries, from.ShortHash(), to.ShortHash())
+				task = ptyTask
+			} else {
+				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash, self.c.Modes().Filtering.GetPath())
+				task = types.NewRunPtyTask(cmdObj.GetCmd())
+			}
+
+			self.c.RenderToMainViews(types.RefreshMainOpts{
+				Pair: self.c.MainViewPairs().Normal,
+				Main: &types.ViewUpdateOpts{
+					Title:    "Reflog Entry",
+					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
+					Task:     task,
+				},
+			})
+		})
+	}
+}
+
+func (self *BranchReflogController) canRestore(entry *models.Commit) *types.DisabledReason {
+	if _, ok := self.context().GetRef().(*models.Branch); !ok {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRestoreRemoteBranch}
+	}
+
+	// the most recent entry is where the branch currently points at
+	if entry.Hash == self.context().GetCommits()[0].Hash {
+		return &types.DisabledReason{Text: self.c.Tr.AlreadyAtReflogEntry}
+	}
+
+	return nil
+}
+
+func (self *BranchReflogController) restore(entry *models.Commit) error {
+	branch := self.context().GetRef().(*models.Branch)
+	currentHash := self.context().GetCommits()[0].Hash
+
+	prompt := self.c.Tr.RestoreBranchPrompt
+	if branch.Head {
+		prompt = self.c.Tr.RestoreCheckedOutBranchPrompt
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RestoreBranchTitle,
+		Prompt: utils.ResolvePlaceholderString(prompt, map[string]string{
+			"branch":      branch.Name,
+			"currentHash": utils.ShortHash(currentHash),
+			"hash":        entry.ShortHash(),
+			"entry":       entry.Name,
+			"undoKey":     keybindings.Label(self.c.UserConfig().Keybinding.Universal.Undo),
+		}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RestoreBranch)
+			return self.c.Helpers().Refs.RestoreBranchFromReflog(branch, entry)
+		},
+	})
+
+	return nil
+}
@@ -142,6 +142,13 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewReflog),
+			Handler:           self.withItem(self.viewReflog),
+			GetDisabledReason: self.require(self.singleItemSelected(self.branchIsReal)),
+			Description:       self.c.Tr.ViewBranchReflog,
+			Tooltip:           self.c.Tr.ViewBranchReflogTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.RenameBranch),
 			Handler:           self.withItem(self.rename),
@@ -682,6 +689,10 @@ func (self *BranchesController) createResetMenu(selectedBranch *models.Branch) e
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.Name)
 }
 
+func (self *BranchesController) viewReflog(branch *models.Branch) error {
+	return self.c.Helpers().Refs.ViewBranchReflog(branch)
+}
+
 func (self *BranchesController) rename(branch *models.Branch) error {
 	promptForNewName := func() error {
 		self.c.Prompt(types.PromptOpts{
@@ -735,7 +746,15 @@ func (self *BranchesController) newBranch(selectedBranch *models.Branch) error {
 }
 
 func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Branch, checkedOutBranch *models.Branch) error {
-	menuItems := make([]*types.MenuItem, 0, 4)
+	menuItems := make([]*types.MenuItem, 0, 5)
+
+	menuItems = append(menuItems, &types.MenuItem{
+		LabelColumns: []string{self.c.Tr.CreatePullRequestInLazygit},
+		Tooltip:      self.c.Tr.CreatePullRequestInLazygitTooltip,
+		OnPress: func() error {
+			return self.c.Helpers().PullRequests.Create(selectedBranch)
+		},
+	})
 
 	fromToLabelColumns := func(from string, to string) []string {
 		return []string{fmt.Sprintf("%s → %s", from, to)}
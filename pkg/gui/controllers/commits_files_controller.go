@@ -55,6 +55,20 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Tooltip:           self.c.Tr.CheckoutCommitFileTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.ApplyToWorkingTree),
+			Handler:           self.withItems(self.applyToWorkingTree),
+			GetDisabledReason: self.require(self.itemsSelected()),
+			Description:       self.c.Tr.ApplyFilesToWorkingTree,
+			Tooltip:           self.c.Tr.ApplyFilesToWorkingTreeTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.OpenBlame),
+			Handler:           self.withItem(self.openBlame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItems(self.discard),
@@ -159,8 +173,16 @@ func (self *CommitFilesController) GetOnRenderToMain() func() {
 		from, to := self.context().GetFromAndToForDiff()
 		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(from)
 
-		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
-		task := types.NewRunPtyTask(cmdObj.GetCmd())
+		var task types.UpdateTask
+		// For files tracked by LFS the diff would only show the pointer files,
+		// so we show a summary of the change to the LFS object instead
+		if node.File != nil && node.File.IsLfs {
+			plainCmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), true)
+			task = self.c.Helpers().Diff.GetUpdateTaskForLfsPointerDiff(plainCmdObj)
+		} else {
+			cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
+			task = types.NewRunPtyTask(cmdObj.GetCmd())
+		}
 
 		pair := self.c.MainViewPairs().Normal
 		if node.File != nil {
@@ -267,6 +289,69 @@ func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error
 	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
 }
 
+// Applies the changes of the selected files to the working tree; this is most
+// useful for restoring only some of the files of a stash entry. We build the
+// patch with the patch builder, so an existing custom patch has to be discarded
+// first.
+func (self *CommitFilesController) applyToWorkingTree(selectedNodes []*filetree.CommitFileNode) error {
+	apply := func() error {
+		if err := self.startPatchBuilder(); err != nil {
+			return err
+		}
+
+		for _, node := range normalisedSelectedCommitFileNodes(selectedNodes) {
+			err := node.ForEachFile(func(file *models.CommitFile) error {
+				return self.c.Git().Patch.PatchBuilder.AddFileWhole(file.Name)
+			})
+			if err != nil {
+				self.c.Git().Patch.PatchBuilder.Reset()
+				return err
+			}
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.ApplyFilesToWorkingTree)
+		err := self.c.Git().Patch.ApplyCustomPatch(false, true)
+		self.c.Git().Patch.PatchBuilder.Reset()
+		if err != nil {
+			return err
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	}
+
+	if self.c.Git().Patch.PatchBuilder.Active() {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.DiscardPatch,
+			Prompt: self.c.Tr.DiscardPatchConfirm,
+			HandleConfirm: func() error {
+				self.c.Git().Patch.PatchBuilder.Reset()
+				return apply()
+			},
+		})
+
+		return nil
+	}
+
+	return apply()
+}
+
+func (self *CommitFilesController) openBlame(node *filetree.CommitFileNode) error {
+	_, to := self.context().GetFromAndToForDiff()
+	return self.c.Helpers().Blame.OpenBlame(node.GetPath(), to, 1)
+}
+
+func (self *CommitFilesController) canBlame(node *filetree.CommitFileNode) *types.DisabledReason {
+	if !node.IsFile() {
+		return &types.DisabledReason{Text: self.c.Tr.ErrCannotBlameDirectory}
+	}
+
+	if node.File.Deleted() {
+		return &types.DisabledReason{Text: self.c.Tr.ErrCannotBlameDeletedFile}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) discard(selectedNodes []*filetree.CommitFileNode) error {
 	parentContext := self.c.Context().Current().GetParentContext()
 	if parentContext == nil || parentContext.GetKey() != context.LOCAL_COMMITS_CONTEXT_KEY {
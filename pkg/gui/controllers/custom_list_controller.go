@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type CustomListController struct {
+	baseController
+	*ListControllerTrait[*models.CustomListItem]
+	c *ControllerCommon
+}
+
+var _ types.IController = &CustomListController{}
+
+func NewCustomListController(
+	c *ControllerCommon,
+) *CustomListController {
+	return &CustomListController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.CustomListItem](
+			c,
+			c.Contexts().CustomList,
+			c.Contexts().CustomList.GetSelected,
+			c.Contexts().CustomList.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *CustomListController) GetOnRenderToMain() func() {
+	return func() {
+		item := self.context().GetSelected()
+		if item == nil {
+			return
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.CustomListMainTitle,
+				Task:  types.NewRenderStringTask(item.Line),
+			},
+		})
+	}
+}
+
+func (self *CustomListController) context() *context.CustomListContext {
+	return self.c.Contexts().CustomList
+}
@@ -29,6 +29,20 @@ func (self *DiffingMenuAction) Call() error {
 		}...)
 	}
 
+	// Offer to compare the two versions of a rebased branch: either the
+	// branch and its upstream, or the ref that we're diffing against and the
+	// selected one
+	if len(names) > 1 {
+		menuItems = append(menuItems, self.rangeDiffMenuItem(names[1], names[0]))
+	}
+	if self.c.Modes().Diffing.Active() && len(names) > 0 && names[0] != self.c.Modes().Diffing.Ref {
+		oldRange, newRange := self.c.Modes().Diffing.Ref, names[0]
+		if self.c.Modes().Diffing.Reverse {
+			oldRange, newRange = newRange, oldRange
+		}
+		menuItems = append(menuItems, self.rangeDiffMenuItem(oldRange, newRange))
+	}
+
 	menuItems = append(menuItems, []*types.MenuItem{
 		{
 			Label: self.c.Tr.EnterRefToDiff,
@@ -45,6 +59,11 @@ func (self *DiffingMenuAction) Call() error {
 				return nil
 			},
 		},
+		{
+			Label:   self.c.Tr.EnterRangesForRangeDiff,
+			Tooltip: self.c.Tr.EnterRangesForRangeDiffTooltip,
+			OnPress: self.promptForRangeDiff,
+		},
 	}...)
 
 	if self.c.Modes().Diffing.Active() {
@@ -68,3 +87,33 @@ func (self *DiffingMenuAction) Call() error {
 
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.DiffingMenuTitle, Items: menuItems})
 }
+
+func (self *DiffingMenuAction) rangeDiffMenuItem(oldRange string, newRange string) *types.MenuItem {
+	return &types.MenuItem{
+		Label:   fmt.Sprintf(self.c.Tr.ShowRangeDiff, oldRange, newRange),
+		Tooltip: self.c.Tr.ShowRangeDiffTooltip,
+		OnPress: func() error {
+			return self.c.Helpers().Diff.OpenRangeDiff(oldRange, newRange)
+		},
+	}
+}
+
+func (self *DiffingMenuAction) promptForRangeDiff() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.EnterOldRange,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
+		HandleConfirm: func(oldRange string) error {
+			self.c.Prompt(types.PromptOpts{
+				Title:               self.c.Tr.EnterNewRange,
+				FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRefsSuggestionsFunc(),
+				HandleConfirm: func(newRange string) error {
+					return self.c.Helpers().Diff.OpenRangeDiff(strings.TrimSpace(oldRange), strings.TrimSpace(newRange))
+				},
+			})
+
+			return nil
+		},
+	})
+
+	return nil
+}
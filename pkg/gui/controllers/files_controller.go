@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -58,6 +59,20 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description: self.c.Tr.CopyToClipboardMenu,
 			OpensMenu:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenBlame),
+			Handler:           self.withItem(self.openBlame),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canBlame)),
+			Description:       self.c.Tr.OpenBlame,
+			Tooltip:           self.c.Tr.OpenBlameTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewLfsOptions),
+			Handler:     self.openLfsMenu,
+			Description: self.c.Tr.ViewLfsOptions,
+			Tooltip:     self.c.Tr.ViewLfsOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:             opts.GetKey(opts.Config.Files.CommitChanges),
 			Handler:         self.c.Helpers().WorkingTree.HandleCommitPress,
@@ -176,10 +191,12 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description:       self.c.Tr.OpenDiffTool,
 		},
 		{
-			Key:         opts.GetKey(opts.Config.Files.OpenMergeTool),
-			Handler:     self.c.Helpers().WorkingTree.OpenMergeTool,
-			Description: self.c.Tr.OpenMergeTool,
-			Tooltip:     self.c.Tr.OpenMergeToolTooltip,
+			Key:               opts.GetKey(opts.Config.Files.OpenMergeOptions),
+			Handler:           self.withItems(self.openMergeConflictMenu),
+			GetDisabledReason: self.require(self.anyFilesWithMergeConflicts),
+			Description:       self.c.Tr.OpenMergeOptions,
+			Tooltip:           self.c.Tr.OpenMergeOptionsTooltip,
+			OpensMenu:         true,
 		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.Fetch),
@@ -278,7 +295,6 @@ func (self *FilesController) GetOnRenderToMain() func() {
 			split := self.c.UserConfig().Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
-			cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, mainShowsStaged)
 			title := self.c.Tr.UnstagedChanges
 			if mainShowsStaged {
 				title = self.c.Tr.StagedChanges
@@ -286,15 +302,13 @@ func (self *FilesController) GetOnRenderToMain() func() {
 			refreshOpts := types.RefreshMainOpts{
 				Pair: pair,
 				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     self.diffTask(node, mainShowsStaged),
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Title:    title,
 				},
 			}
 
 			if split {
-				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
-
 				title := self.c.Tr.StagedChanges
 				if mainShowsStaged {
 					title = self.c.Tr.UnstagedChanges
@@ -303,7 +317,7 @@ func (self *FilesController) GetOnRenderToMain() func() {
 				refreshOpts.Secondary = &types.ViewUpdateOpts{
 					Title:    title,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     self.diffTask(node, true),
 				}
 			}
 
@@ -753,6 +767,26 @@ func (self *FilesController) isResolvingConflicts() bool {
 	return false
 }
 
+func (self *FilesController) anyFilesWithMergeConflicts() *types.DisabledReason {
+	if !lo.SomeBy(self.c.Model().Files, func(file *models.File) bool { return file.HasMergeConflicts }) {
+		return &types.DisabledReason{Text: self.c.Tr.NoFilesWithMergeConflicts}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openMergeConflictMenu(selectedNodes []*filetree.FileNode) error {
+	files := []*models.File{}
+	for _, node := range normalisedSelectedNodes(selectedNodes) {
+		_ = node.ForEachFile(func(file *models.File) error {
+			files = append(files, file)
+			return nil
+		})
+	}
+
+	return self.c.Helpers().WorkingTree.CreateMergeConflictMenu(files)
+}
+
 func (self *FilesController) handleStatusFilterPressed() error {
 	currentFilter := self.context().GetFilter()
 	return self.c.Menu(types.CreateMenuOptions{
@@ -856,6 +890,125 @@ func (self *FilesController) canEditFiles(nodes []*filetree.FileNode) *types.Dis
 	return nil
 }
 
+func (self *FilesController) openBlame(node *filetree.FileNode) error {
+	return self.c.Helpers().Blame.OpenBlame(node.GetPath(), "", 1)
+}
+
+func (self *FilesController) openLfsMenu() error {
+	var file *models.File
+	if node := self.context().GetSelected(); node != nil {
+		file = node.File
+	}
+
+	var lockDisabledReason, unlockDisabledReason *types.DisabledReason
+	if file == nil || !file.IsLfs {
+		lockDisabledReason = &types.DisabledReason{Text: self.c.Tr.SelectedItemIsNotLfsFile}
+		unlockDisabledReason = lockDisabledReason
+	} else if file.LfsLock != nil {
+		lockDisabledReason = &types.DisabledReason{Text: self.c.Tr.LfsFileAlreadyLocked}
+	} else {
+		unlockDisabledReason = &types.DisabledReason{Text: self.c.Tr.LfsFileNotLocked}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LfsMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label:          self.c.Tr.LfsLockSelectedFile,
+				DisabledReason: lockDisabledReason,
+				OnPress: func() error {
+					return self.lfsLock(file.Name)
+				},
+				Key: 'l',
+			},
+			{
+				Label:          self.c.Tr.LfsUnlockSelectedFile,
+				DisabledReason: unlockDisabledReason,
+				OnPress: func() error {
+					return self.lfsUnlock(file.Name)
+				},
+				Key: 'u',
+			},
+			{
+				Label:   self.c.Tr.LfsLockFileByPath,
+				OnPress: self.lfsLockByPath,
+				Key:     'L',
+			},
+			{
+				Label:   self.c.Tr.LfsUnlockFileByPath,
+				OnPress: self.lfsUnlockByPath,
+				Key:     'U',
+			},
+		},
+	})
+}
+
+// Locking lets us edit files that can't be merged (e.g. images) without
+// others editing them at the same time. Since you'll usually want to lock a
+// file before changing it, we also let you lock files that aren't in the
+// files panel.
+func (self *FilesController) lfsLockByPath() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.LfsLockFileByPathTitle,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetFilePathSuggestionsFunc(),
+		HandleConfirm:       self.lfsLock,
+	})
+
+	return nil
+}
+
+func (self *FilesController) lfsUnlockByPath() error {
+	lockedPaths := lo.Map(self.c.Git().Lfs.LocalLocks(), func(lock *models.LfsLock, _ int) string {
+		return lock.Path
+	})
+
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.LfsUnlockFileByPathTitle,
+		FindSuggestionsFunc: helpers.FilterFunc(lockedPaths, self.c.UserConfig().Gui.UseFuzzySearch()),
+		HandleConfirm:       self.lfsUnlock,
+	})
+
+	return nil
+}
+
+func (self *FilesController) lfsLock(path string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LfsLockingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsLockFile)
+		if err := self.c.Git().Lfs.Lock(path); err != nil {
+			return err
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	})
+}
+
+func (self *FilesController) lfsUnlock(path string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LfsUnlockingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LfsUnlockFile)
+		if err := self.c.Git().Lfs.Unlock(path, false); err != nil {
+			return err
+		}
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	})
+}
+
+func (self *FilesController) canBlame(node *filetree.FileNode) *types.DisabledReason {
+	if !node.IsFile() {
+		return &types.DisabledReason{Text: self.c.Tr.ErrCannotBlameDirectory}
+	}
+
+	if !node.File.Tracked {
+		return &types.DisabledReason{Text: self.c.Tr.ErrCannotBlameUntrackedFile}
+	}
+
+	if node.File.Deleted {
+		return &types.DisabledReason{Text: self.c.Tr.ErrCannotBlameDeletedFile}
+	}
+
+	return nil
+}
+
 func (self *FilesController) Open() error {
 	node := self.context().GetSelected()
 	if node == nil {
@@ -1102,6 +1255,18 @@ func (self *FilesController) handleStashSave(stashFunc func(message string) erro
 	return nil
 }
 
+func (self *FilesController) diffTask(node *filetree.FileNode, cached bool) types.UpdateTask {
+	// For files tracked by LFS the diff would only show the pointer files,
+	// so we show a summary of the change to the LFS object instead
+	if node.File != nil && node.File.IsLfs {
+		plainCmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, true, cached)
+		return self.c.Helpers().Diff.GetUpdateTaskForLfsPointerDiff(plainCmdObj)
+	}
+
+	cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, cached)
+	return types.NewRunPtyTask(cmdObj.GetCmd())
+}
+
 func (self *FilesController) onClickMain(opts gocui.ViewMouseBindingOpts) error {
 	return self.EnterFile(types.OnFocusOpts{ClickedWindowName: "main", ClickedViewLineIdx: opts.Y})
 }
@@ -1186,6 +1351,27 @@ func (self *FilesController) canRemove(selectedNodes []*filetree.FileNode) *type
 	return nil
 }
 
+// The paths whose files are affected by discarding the given nodes. This
+// includes the old paths of renamed files, since discarding a rename brings
+// back the old file. Returns nil (meaning all files) if the root is selected.
+func discardedPaths(nodes []*filetree.FileNode) []string {
+	paths := []string{}
+	for _, node := range nodes {
+		if node.GetPath() == "" {
+			return nil
+		}
+		paths = append(paths, node.GetPath())
+
+		for _, leaf := range node.GetLeaves() {
+			if file := leaf.GetFile(); file != nil && file.PreviousName != "" {
+				paths = append(paths, file.PreviousName)
+			}
+		}
+	}
+
+	return lo.Uniq(paths)
+}
+
 func (self *FilesController) remove(selectedNodes []*filetree.FileNode) error {
 	submodules := self.c.Model().Submodules
 
@@ -1218,10 +1404,15 @@ func (self *FilesController) remove(selectedNodes []*filetree.FileNode) error {
 				defer self.context().CancelRangeSelect()
 			}
 
-			for _, node := range selectedNodes {
-				if err := self.c.Git().WorkingTree.DiscardAllDirChanges(node); err != nil {
-					return err
+			if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.DiscardAllChangesInFile, discardedPaths(selectedNodes), func() error {
+				for _, node := range selectedNodes {
+					if err := self.c.Git().WorkingTree.DiscardAllDirChanges(node); err != nil {
+						return err
+					}
 				}
+				return nil
+			}); err != nil {
+				return err
 			}
 
 			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
@@ -1244,10 +1435,15 @@ func (self *FilesController) remove(selectedNodes []*filetree.FileNode) error {
 				defer self.context().CancelRangeSelect()
 			}
 
-			for _, node := range selectedNodes {
-				if err := self.c.Git().WorkingTree.DiscardUnstagedDirChanges(node); err != nil {
-					return err
+			if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.DiscardAllUnstagedChangesInFile, discardedPaths(selectedNodes), func() error {
+				for _, node := range selectedNodes {
+					if err := self.c.Git().WorkingTree.DiscardUnstagedDirChanges(node); err != nil {
+						return err
+					}
 				}
+				return nil
+			}); err != nil {
+				return err
 			}
 
 			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.WORKTREES}})
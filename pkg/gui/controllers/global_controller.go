@@ -41,6 +41,12 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Tooltip:     self.c.Tr.ViewMergeRebaseOptionsTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenOperationHistory),
+			Handler:     opts.Guards.NoPopupPanel(self.c.Helpers().OperationHistory.Open),
+			Description: self.c.Tr.ViewOperationHistory,
+			Tooltip:     self.c.Tr.ViewOperationHistoryTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Refresh),
 			Handler:     opts.Guards.NoPopupPanel(self.refresh),
@@ -0,0 +1,90 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type BlameHelper struct {
+	c *HelperCommon
+
+	subCommitsHelper *SubCommitsHelper
+}
+
+func NewBlameHelper(
+	c *HelperCommon,
+	subCommitsHelper *SubCommitsHelper,
+) *BlameHelper {
+	return &BlameHelper{
+		c:                c,
+		subCommitsHelper: subCommitsHelper,
+	}
+}
+
+// Blames the file at the given path as of the given commit (or the working
+// tree if commit is empty) and shows the result in the blame view, selecting
+// the given line number.
+func (self *BlameHelper) OpenBlame(path string, commit string, lineNumber int) error {
+	var lines []*models.BlameLine
+	err := self.c.WithWaitingStatusSync(self.c.Tr.LoadingBlame, func() error {
+		var err error
+		lines, err = self.c.Git().Blame.BlameFile(path, commit)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	blameContext := self.context()
+	blameContext.SetBlame(path, commit, lines)
+	blameContext.SelectLineNumber(lineNumber)
+	blameContext.ClearSearchString()
+	blameContext.GetView().ClearSearch()
+	blameContext.GetView().Title = self.title(path, commit)
+
+	self.c.PostRefreshUpdate(blameContext)
+
+	self.c.Context().Push(blameContext)
+	return nil
+}
+
+// Blames the file as of the parent of the commit that last changed the given
+// line, so that you can see who changed the line before that commit did.
+func (self *BlameHelper) BlameParentCommit(line *models.BlameLine) error {
+	return self.OpenBlame(line.Commit.PreviousFilename, line.Commit.PreviousHash, line.OriginalLineNumber)
+}
+
+// Selects the commit that last changed the given line in the commits panel.
+// If the commit is not among the loaded commits of the current branch (e.g.
+// because it's too far back in history), we show the commit and its ancestors
+// in the sub-commits view instead.
+func (self *BlameHelper) GoToCommit(line *models.BlameLine) error {
+	hash := line.Commit.Hash
+	localCommitsContext := self.c.Contexts().LocalCommits
+	if localCommitsContext.SelectCommitByHash(hash) {
+		self.c.Context().Push(localCommitsContext)
+		return nil
+	}
+
+	commit := &models.Commit{Hash: hash, Name: line.Commit.Summary}
+	return self.subCommitsHelper.ViewSubCommits(ViewSubCommitsOpts{
+		Ref:      commit,
+		TitleRef: commit.ShortRefName(),
+		Context:  localCommitsContext,
+	})
+}
+
+func (self *BlameHelper) title(path string, commit string) string {
+	if commit == "" {
+		return fmt.Sprintf(self.c.Tr.BlameDynamicTitle, path)
+	}
+
+	return fmt.Sprintf(self.c.Tr.BlameDynamicTitleAtCommit, path, utils.ShortHash(commit))
+}
+
+func (self *BlameHelper) context() *context.BlameContext {
+	return self.c.Contexts().Blame
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -14,14 +15,16 @@ import (
 )
 
 type BranchesHelper struct {
-	c              *HelperCommon
-	worktreeHelper *WorktreeHelper
+	c                      *HelperCommon
+	worktreeHelper         *WorktreeHelper
+	operationHistoryHelper *OperationHistoryHelper
 }
 
-func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper) *BranchesHelper {
+func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper, operationHistoryHelper *OperationHistoryHelper) *BranchesHelper {
 	return &BranchesHelper{
-		c:              c,
-		worktreeHelper: worktreeHelper,
+		c:                      c,
+		worktreeHelper:         worktreeHelper,
+		operationHistoryHelper: operationHistoryHelper,
 	}
 }
 
@@ -42,8 +45,7 @@ func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error
 	doDelete := func() error {
 		return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(_ gocui.Task) error {
 			self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranch)
-			branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
-			if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
+			if err := self.deleteLocalBranches(branches); err != nil {
 				return err
 			}
 			selectionStart, _ := self.c.Contexts().Branches.GetSelectionRange()
@@ -172,8 +174,7 @@ func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branc
 				}
 
 				self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranch)
-				branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
-				if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
+				if err := self.deleteLocalBranches(branches); err != nil {
 					return err
 				}
 
@@ -252,6 +253,14 @@ func (self *BranchesHelper) allBranchesMerged(branches []*models.Branch) (bool,
 	return allBranchesMerged, nil
 }
 
+func (self *BranchesHelper) deleteLocalBranches(branches []*models.Branch) error {
+	branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+	action := fmt.Sprintf("%s %s", self.c.Tr.Actions.DeleteLocalBranch, strings.Join(branchNames, ", "))
+	return self.operationHistoryHelper.Track(action, func() error {
+		return self.c.Git().Branch.LocalDelete(branchNames, true)
+	})
+}
+
 func (self *BranchesHelper) deleteRemoteBranches(remoteBranches []*models.RemoteBranch, task gocui.Task) error {
 	remotes := lo.GroupBy(remoteBranches, func(branch *models.RemoteBranch) string { return branch.RemoteName })
 	for remote, branches := range remotes {
@@ -14,7 +14,8 @@ import (
 type CherryPickHelper struct {
 	c *HelperCommon
 
-	rebaseHelper *MergeAndRebaseHelper
+	rebaseHelper           *MergeAndRebaseHelper
+	operationHistoryHelper *OperationHistoryHelper
 }
 
 // I'm using the analogy of copy+paste in the terminology here because it's intuitively what's going on,
@@ -23,10 +24,12 @@ type CherryPickHelper struct {
 func NewCherryPickHelper(
 	c *HelperCommon,
 	rebaseHelper *MergeAndRebaseHelper,
+	operationHistoryHelper *OperationHistoryHelper,
 ) *CherryPickHelper {
 	return &CherryPickHelper{
-		c:            c,
-		rebaseHelper: rebaseHelper,
+		c:                      c,
+		rebaseHelper:           rebaseHelper,
+		operationHistoryHelper: operationHistoryHelper,
 	}
 }
 
@@ -97,7 +100,9 @@ func (self *CherryPickHelper) Paste() error {
 
 			return self.c.WithWaitingStatus(self.c.Tr.CherryPickingStatus, func(gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.CherryPick)
-				err := self.c.Git().Rebase.CherryPickCommits(self.getData().CherryPickedCommits)
+				err := self.operationHistoryHelper.Track(self.c.Tr.Actions.CherryPick, func() error {
+					return self.c.Git().Rebase.CherryPickCommits(self.getData().CherryPickedCommits)
+				})
 				err = self.rebaseHelper.CheckMergeOrRebase(err)
 				if err != nil {
 					return err
@@ -153,6 +158,7 @@ func (self *CherryPickHelper) rerender() {
 		self.c.Contexts().LocalCommits,
 		self.c.Contexts().ReflogCommits,
 		self.c.Contexts().SubCommits,
+		self.c.Contexts().BranchReflog,
 	} {
 		self.c.PostRefreshUpdate(context)
 	}
@@ -129,6 +129,9 @@ type OpenCommitMessagePanelOpts struct {
 	OnConfirm        func(summary string, description string) error
 	OnSwitchToEditor func(string) error
 	InitialMessage   string
+	// Turns off git.commit.autoWrapCommitMessage for text that isn't a commit
+	// message, e.g. a pull request description, which is shown unwrapped
+	DisableAutoWrap bool
 }
 
 func (self *CommitsHelper) OpenCommitMessagePanel(opts *OpenCommitMessagePanelOpts) {
@@ -148,6 +151,7 @@ func (self *CommitsHelper) OpenCommitMessagePanel(opts *OpenCommitMessagePanelOp
 		opts.OnSwitchToEditor,
 	)
 
+	self.c.Views().CommitDescription.TextArea.AutoWrap = self.c.UserConfig().Git.Commit.AutoWrapCommitMessage && !opts.DisableAutoWrap
 	self.UpdateCommitPanelView(opts.InitialMessage)
 
 	self.c.Context().Push(self.c.Contexts().CommitMessage)
@@ -1,13 +1,17 @@
 package helpers
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
@@ -70,6 +74,58 @@ func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Comm
 	return types.NewRunPtyTask(cmdObj.GetCmd())
 }
 
+// Takes a command producing a plain diff of a file tracked by Git LFS, and
+// returns a task that runs it and renders a summary of the change to the LFS
+// object instead of the pointer diff. If the output turns out not to be a
+// pointer diff (e.g. because the file was committed before it was tracked by
+// LFS), the plain diff is shown as is.
+func (self *DiffHelper) GetUpdateTaskForLfsPointerDiff(plainDiffCmdObj oscommands.ICmdObj) types.UpdateTask {
+	return &types.RunCommandTask{
+		Cmd: plainDiffCmdObj.GetCmd(),
+		FormatOutput: func(diff string) string {
+			oldPointer, newPointer, ok := git_commands.ParseLfsPointerDiff(diff)
+			if !ok {
+				return diff
+			}
+
+			return presentation.GetLfsPointerDiffSummary(oldPointer, newPointer, self.c.Tr)
+		},
+	}
+}
+
+// Shows the range-diff of two versions of a series of commits as a list of
+// commit pairs, in place of the current side panel.
+func (self *DiffHelper) OpenRangeDiff(oldRange string, newRange string) error {
+	var pairs []*models.RangeDiffPair
+	err := self.c.WithWaitingStatusSync(self.c.Tr.LoadingRangeDiff, func() error {
+		var err error
+		pairs, err = self.c.Git().Diff.RangeDiff(oldRange, newRange)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(pairs) == 0 {
+		return errors.New(self.c.Tr.RangeDiffIsEmpty)
+	}
+
+	parentContext := self.c.Context().CurrentSide()
+	rangeDiffContext := self.c.Contexts().RangeDiff
+	rangeDiffContext.SetRangeDiff(oldRange, newRange, pairs)
+	rangeDiffContext.SetSelection(0)
+	rangeDiffContext.SetParentContext(parentContext)
+	rangeDiffContext.SetWindowName(parentContext.GetWindowName())
+	rangeDiffContext.ClearSearchString()
+	rangeDiffContext.GetView().ClearSearch()
+	rangeDiffContext.GetView().Title = fmt.Sprintf(self.c.Tr.RangeDiffDynamicTitle, oldRange, newRange)
+
+	self.c.PostRefreshUpdate(rangeDiffContext)
+
+	self.c.Context().Push(rangeDiffContext)
+	return nil
+}
+
 func (self *DiffHelper) ExitDiffMode() error {
 	self.c.Modes().Diffing = diffing.New()
 	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
@@ -52,6 +52,10 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	Blame             *BlameHelper
+	Journal           *JournalHelper
+	OperationHistory  *OperationHistoryHelper
+	PullRequests      *PullRequestsHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -89,5 +93,9 @@ func NewStubHelpers() *Helpers {
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		Blame:             &BlameHelper{},
+		Journal:           &JournalHelper{},
+		OperationHistory:  &OperationHistoryHelper{},
+		PullRequests:      &PullRequestsHelper{},
 	}
 }
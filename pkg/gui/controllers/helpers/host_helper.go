@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 )
 
@@ -24,7 +26,7 @@ func NewHostHelper(
 }
 
 func (self *HostHelper) GetPullRequestURL(from string, to string) (string, error) {
-	mgr, err := self.getHostingServiceMgr()
+	mgr, err := self.getHostingServiceMgr("origin")
 	if err != nil {
 		return "", err
 	}
@@ -32,17 +34,85 @@ func (self *HostHelper) GetPullRequestURL(from string, to string) (string, error
 }
 
 func (self *HostHelper) GetCommitURL(commitHash string) (string, error) {
-	mgr, err := self.getHostingServiceMgr()
+	mgr, err := self.getHostingServiceMgr("origin")
 	if err != nil {
 		return "", err
 	}
 	return mgr.GetCommitURL(commitHash)
 }
 
+// GetAPIClient returns a client for the API of the hosting service of the
+// 'origin' remote, authenticated with the token from the config or, failing
+// that, from the provider's command line tool
+func (self *HostHelper) GetAPIClient() (*hosting_service.APIClient, error) {
+	return self.GetAPIClientForRemote("origin")
+}
+
+func (self *HostHelper) GetAPIClientForRemote(remoteName string) (*hosting_service.APIClient, error) {
+	mgr, err := self.getHostingServiceMgr(remoteName)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, webDomain, err := mgr.GetServiceInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return mgr.NewAPIClient(self.getAPIToken(provider, webDomain))
+}
+
+// GetPullRequestHeadRef returns the ref that the given remote keeps the head
+// of a pull request in
+func (self *HostHelper) GetPullRequestHeadRef(remoteName string, number int) (string, error) {
+	mgr, err := self.getHostingServiceMgr(remoteName)
+	if err != nil {
+		return "", err
+	}
+	return mgr.GetPullRequestHeadRef(number)
+}
+
+// GetRepoOwner returns the user or organization that the given remote's repo
+// belongs to
+func (self *HostHelper) GetRepoOwner(remoteName string) (string, error) {
+	mgr, err := self.getHostingServiceMgr(remoteName)
+	if err != nil {
+		return "", err
+	}
+	return mgr.GetRepoOwner()
+}
+
+func (self *HostHelper) getAPIToken(provider string, webDomain string) string {
+	if token, ok := self.c.UserConfig().Git.PullRequests.Tokens[webDomain]; ok {
+		return token
+	}
+
+	var cmdArgs []string
+	switch provider {
+	case "github":
+		cmdArgs = []string{"gh", "auth", "token", "--hostname", webDomain}
+	case "gitlab":
+		cmdArgs = []string{"glab", "config", "get", "token", "--host", webDomain}
+	default:
+		return ""
+	}
+
+	output, err := self.c.OS().Cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		// Not installed or not logged in; we'll try without a token, which
+		// works for public repos with providers that allow anonymous API
+		// requests (NewAPIClient fails for those that don't)
+		self.c.Log.Infof("Could not get API token from %s: %v", cmdArgs[0], err)
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
 // getting this on every request rather than storing it in state in case our remoteURL changes
 // from one invocation to the next.
-func (self *HostHelper) getHostingServiceMgr() (*hosting_service.HostingServiceMgr, error) {
-	remoteUrl, err := self.c.Git().Remote.GetRemoteURL("origin")
+func (self *HostHelper) getHostingServiceMgr(remoteName string) (*hosting_service.HostingServiceMgr, error) {
+	remoteUrl, err := self.c.Git().Remote.GetRemoteURL(remoteName)
 	if err != nil {
 		return nil, err
 	}
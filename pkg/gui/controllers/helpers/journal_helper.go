@@ -0,0 +1,155 @@
+package helpers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// The undo journal complements the reflog-based undo: before a destructive
+// file-level action (like discarding changes) we snapshot the index and the
+// working tree, so that the action can be undone by restoring the snapshot.
+// The journal only lives in memory, so it doesn't survive a restart of lazygit.
+
+// Oldest entries are dropped once the journal gets longer than this
+const maxJournalEntries = 100
+
+type JournalHelper struct {
+	c *HelperCommon
+}
+
+func NewJournalHelper(c *HelperCommon) *JournalHelper {
+	return &JournalHelper{
+		c: c,
+	}
+}
+
+// WithSnapshot takes a snapshot of the files at the given paths (or of all
+// files, if paths is empty), runs the given action, and records the snapshot
+// in the journal if the action succeeded. Undoing the action only restores
+// those paths. If the snapshot can't be taken (e.g. because there are
+// unresolved merge conflicts, which can't be written to a tree) we still run
+// the action, and tell the user that it won't be undoable.
+func (self *JournalHelper) WithSnapshot(action string, paths []string, f func() error) error {
+	snapshot, err := self.c.Git().WorkingTree.CreateSnapshot(paths)
+	if err != nil {
+		self.c.Log.Warnf("Could not take snapshot before '%s': %v", action, err)
+		self.c.ErrorToast(utils.ResolvePlaceholderString(self.c.Tr.CantUndoWithoutSnapshot, map[string]string{"action": action}))
+		return f()
+	}
+
+	head := self.c.Git().WorkingTree.HeadHash()
+	if err := f(); err != nil {
+		return err
+	}
+
+	model := self.c.Model()
+	model.Journal = append(model.Journal, &models.JournalEntry{
+		Action:    action,
+		Head:      head,
+		Before:    snapshot,
+		Timestamp: time.Now().Unix(),
+	})
+	if len(model.Journal) > maxJournalEntries {
+		model.Journal = model.Journal[len(model.Journal)-maxJournalEntries:]
+	}
+	// A new action invalidates whatever we could have redone
+	model.UndoneJournal = nil
+
+	return nil
+}
+
+// Returns the most recent entry that can be undone, or nil
+func (self *JournalHelper) LastEntry() *models.JournalEntry {
+	journal := self.c.Model().Journal
+	if len(journal) == 0 {
+		return nil
+	}
+
+	return journal[len(journal)-1]
+}
+
+// Returns the most recently undone entry, or nil
+func (self *JournalHelper) LastUndoneEntry() *models.JournalEntry {
+	undoneJournal := self.c.Model().UndoneJournal
+	if len(undoneJournal) == 0 {
+		return nil
+	}
+
+	return undoneJournal[len(undoneJournal)-1]
+}
+
+// Undo restores the files to how they were before the given entry's action,
+// after taking a snapshot of their current state so that the undo can be
+// redone.
+func (self *JournalHelper) Undo(entry *models.JournalEntry) error {
+	if err := self.checkHead(entry); err != nil {
+		return err
+	}
+
+	after, err := self.c.Git().WorkingTree.CreateSnapshot(entry.Before.Paths)
+	if err != nil {
+		return err
+	}
+
+	if err := self.c.Git().WorkingTree.RestoreSnapshot(entry.Before); err != nil {
+		return err
+	}
+
+	entry.After = after
+	entry.UndoneTimestamp = time.Now().Unix()
+
+	model := self.c.Model()
+	model.Journal = model.Journal[:len(model.Journal)-1]
+	model.UndoneJournal = append(model.UndoneJournal, entry)
+
+	return self.refresh()
+}
+
+// Redo restores the files to how they were when the given entry was undone
+func (self *JournalHelper) Redo(entry *models.JournalEntry) error {
+	if err := self.checkHead(entry); err != nil {
+		return err
+	}
+
+	if err := self.c.Git().WorkingTree.RestoreSnapshot(entry.After); err != nil {
+		return err
+	}
+
+	entry.After = nil
+	entry.UndoneTimestamp = 0
+
+	model := self.c.Model()
+	model.UndoneJournal = model.UndoneJournal[:len(model.UndoneJournal)-1]
+	model.Journal = append(model.Journal, entry)
+
+	return self.refresh()
+}
+
+// Restoring a snapshot on top of a different commit than the one it was taken
+// on would show the difference between the two commits as changes, so the
+// entry can only be undone or redone while HEAD is where it was back then.
+func (self *JournalHelper) HeadMatches(entry *models.JournalEntry) bool {
+	return self.c.Git().WorkingTree.HeadHash() == entry.Head
+}
+
+func (self *JournalHelper) checkHead(entry *models.JournalEntry) error {
+	if !self.HeadMatches(entry) {
+		return errors.New(utils.ResolvePlaceholderString(
+			self.c.Tr.CantUndoJournalEntryHeadMoved,
+			map[string]string{"action": entry.Action},
+		))
+	}
+
+	return nil
+}
+
+func (self *JournalHelper) refresh() error {
+	return self.c.Refresh(types.RefreshOptions{
+		Mode:  types.ASYNC,
+		Scope: []types.RefreshableView{types.FILES, types.STAGING, types.WORKTREES},
+	})
+}
@@ -18,17 +18,20 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c          *HelperCommon
-	refsHelper *RefsHelper
+	c                      *HelperCommon
+	refsHelper             *RefsHelper
+	operationHistoryHelper *OperationHistoryHelper
 }
 
 func NewMergeAndRebaseHelper(
 	c *HelperCommon,
 	refsHelper *RefsHelper,
+	operationHistoryHelper *OperationHistoryHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c:          c,
-		refsHelper: refsHelper,
+		c:                      c,
+		refsHelper:             refsHelper,
+		operationHistoryHelper: operationHistoryHelper,
 	}
 }
 
@@ -321,12 +324,12 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 				self.c.LogAction(self.c.Tr.Actions.RebaseBranch)
 				return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(task gocui.Task) error {
 					baseCommit := self.c.Modes().MarkedBaseCommit.GetHash()
-					var err error
-					if baseCommit != "" {
-						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(ref, baseCommit)
-					} else {
-						err = self.c.Git().Rebase.RebaseBranch(ref)
-					}
+					err := self.operationHistoryHelper.Track(self.c.Tr.Actions.RebaseBranch, func() error {
+						if baseCommit != "" {
+							return self.c.Git().Rebase.RebaseBranchFromBaseCommit(ref, baseCommit)
+						}
+						return self.c.Git().Rebase.RebaseBranch(ref)
+					})
 					err = self.CheckMergeOrRebase(err)
 					if err == nil {
 						return self.ResetMarkedBaseCommit()
@@ -372,12 +375,12 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 				self.c.LogAction(self.c.Tr.Actions.RebaseBranch)
 				return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(task gocui.Task) error {
 					baseCommit := self.c.Modes().MarkedBaseCommit.GetHash()
-					var err error
-					if baseCommit != "" {
-						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(baseBranch, baseCommit)
-					} else {
-						err = self.c.Git().Rebase.RebaseBranch(baseBranch)
-					}
+					err := self.operationHistoryHelper.Track(self.c.Tr.Actions.RebaseBranch, func() error {
+						if baseCommit != "" {
+							return self.c.Git().Rebase.RebaseBranchFromBaseCommit(baseBranch, baseCommit)
+						}
+						return self.c.Git().Rebase.RebaseBranch(baseBranch)
+					})
 					err = self.CheckMergeOrRebase(err)
 					if err == nil {
 						return self.ResetMarkedBaseCommit()
@@ -457,8 +460,12 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 func (self *MergeAndRebaseHelper) RegularMerge(refName string) func() error {
 	return func() error {
 		self.c.LogAction(self.c.Tr.Actions.Merge)
-		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{})
-		return self.CheckMergeOrRebase(err)
+		return self.c.WithWaitingStatus(self.c.Tr.MergingStatus, func(gocui.Task) error {
+			err := self.operationHistoryHelper.Track(self.c.Tr.Actions.Merge, func() error {
+				return self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{})
+			})
+			return self.CheckMergeOrRebase(err)
+		})
 	}
 }
 
@@ -0,0 +1,289 @@
+package helpers
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The operation history records high-level actions that changed branches,
+// along with the positions of the local branches before and after them. This
+// lets us show a list of those actions, and roll back a specific one of them
+// by putting the branches back where they were, as long as they haven't been
+// changed again since.
+
+// Oldest operations are dropped once the history gets longer than this
+const maxOperationHistoryEntries = 200
+
+type OperationHistoryHelper struct {
+	c *HelperCommon
+}
+
+func NewOperationHistoryHelper(c *HelperCommon) *OperationHistoryHelper {
+	return &OperationHistoryHelper{
+		c: c,
+	}
+}
+
+// Track runs f, which performs the given action, and adds the action to the
+// operation history if it changed any branches or checked out a different
+// one. Since it takes a snapshot of the branches before and after running f,
+// it must be called from a background goroutine, e.g. inside the
+// WithWaitingStatus callback that runs the action.
+func (self *OperationHistoryHelper) Track(action string, f func() error) error {
+	timestamp := time.Now().Unix()
+	before, err := self.c.Git().Branch.GetRefsSnapshot()
+	if err != nil {
+		self.c.Log.Warnf("Could not record operation '%s': %v", action, err)
+		return f()
+	}
+
+	err = f()
+
+	after, snapshotErr := self.c.Git().Branch.GetRefsSnapshot()
+	if snapshotErr != nil {
+		self.c.Log.Warnf("Could not record operation '%s': %v", action, snapshotErr)
+		return err
+	}
+
+	// Most failed actions don't change anything, but some (like a rebase that
+	// stopped because of conflicts) do, so we record them either way
+	if hasRefChanges(before, after) {
+		operation := &models.Operation{
+			Action:    action,
+			Timestamp: timestamp,
+			Before:    before,
+			After:     after,
+			Changes:   self.describeChanges(before, after),
+		}
+		self.c.OnUIThread(func() error {
+			history := append([]*models.Operation{operation}, self.c.Model().OperationHistory...)
+			self.c.Model().OperationHistory = lo.Slice(history, 0, maxOperationHistoryEntries)
+			if self.c.Context().IsCurrent(self.c.Contexts().OperationHistory) {
+				self.c.PostRefreshUpdate(self.c.Contexts().OperationHistory)
+			}
+			return nil
+		})
+	}
+
+	return err
+}
+
+// Shows the operation history in place of the current side panel
+func (self *OperationHistoryHelper) Open() error {
+	if len(self.c.Model().OperationHistory) == 0 {
+		return errors.New(self.c.Tr.NoOperationsToRollBack)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingOperationHistory, func(gocui.Task) error {
+		return self.reload()
+	})
+}
+
+// Loads the current state of the branches, which decides which operations can
+// still be rolled back, and shows the history
+func (self *OperationHistoryHelper) reload() error {
+	current, err := self.c.Git().Branch.GetRefsSnapshot()
+	if err != nil {
+		return err
+	}
+
+	self.c.OnUIThread(func() error {
+		operationHistoryContext := self.c.Contexts().OperationHistory
+		operationHistoryContext.SetCurrentRefs(current)
+		operationHistoryContext.SetSelection(0)
+		if !self.c.Context().IsCurrent(operationHistoryContext) {
+			parentContext := self.c.Context().CurrentSide()
+			operationHistoryContext.SetParentContext(parentContext)
+			operationHistoryContext.SetWindowName(parentContext.GetWindowName())
+			self.c.Context().Push(operationHistoryContext)
+		}
+		self.c.PostRefreshUpdate(operationHistoryContext)
+		return nil
+	})
+	return nil
+}
+
+func (self *OperationHistoryHelper) RollBack(operation *models.Operation) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RollBackOperationTitle,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.RollBackOperationPrompt,
+			map[string]string{
+				"action":  operation.Action,
+				"changes": strings.Join(operation.Changes, "\n"),
+			},
+		),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.RollingBackStatus, func(gocui.Task) error {
+				action := utils.ResolvePlaceholderString(
+					self.c.Tr.Actions.RollBackOperation,
+					map[string]string{"action": operation.Action},
+				)
+				self.c.LogAction(action)
+				err := self.Track(action, func() error {
+					return self.rollback(operation.Before, operation.After)
+				})
+				if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err == nil {
+					err = refreshErr
+				}
+				if err != nil {
+					return err
+				}
+
+				// The rollback itself shows up at the top, and other operations
+				// may no longer be possible to roll back
+				return self.reload()
+			})
+		},
+	})
+
+	return nil
+}
+
+// Puts the branches (and HEAD) that changed between before and after back to
+// where they were before
+func (self *OperationHistoryHelper) rollback(before *models.RefsSnapshot, after *models.RefsSnapshot) error {
+	branchesToRestore := changedBranches(before, after)
+
+	if headChanged(before, after) {
+		target := before.CheckedOutBranch
+		if target == "" {
+			target = before.Head
+		} else if _, ok := after.Branches[target]; !ok {
+			// The branch we're going back to was deleted as part of the
+			// operation, so recreate it before checking it out
+			if err := self.c.Git().Branch.MoveTo(target, before.Branches[target]); err != nil {
+				return err
+			}
+		}
+
+		if err := self.c.Git().Branch.Checkout(target, git_commands.CheckoutOptions{}); err != nil {
+			return err
+		}
+	}
+
+	for _, branch := range branchesToRestore {
+		beforeHash, existedBefore := before.Branches[branch]
+		switch {
+		case !existedBefore:
+			if err := self.c.Git().Branch.LocalDelete([]string{branch}, true); err != nil {
+				return err
+			}
+		case branch == before.CheckedOutBranch:
+			// We can't force-move the checked out branch; reset it instead,
+			// keeping any local changes
+			if err := self.c.Git().Commit.ResetToCommit(beforeHash, "keep", []string{}); err != nil {
+				return err
+			}
+		default:
+			if err := self.c.Git().Branch.MoveTo(branch, beforeHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// An operation can only be rolled back if everything it changed is still the
+// way the operation left it; otherwise we'd silently throw away later work.
+func (self *OperationHistoryHelper) RollBackDisabledReason(operation *models.Operation, current *models.RefsSnapshot) *types.DisabledReason {
+	if self.c.Git().Status.WorkingTreeState() != enums.REBASE_MODE_NONE {
+		return &types.DisabledReason{Text: self.c.Tr.CantRollBackWhileRebasing}
+	}
+
+	before, after := operation.Before, operation.After
+	if headChanged(before, after) && headChanged(after, current) {
+		return &types.DisabledReason{Text: self.c.Tr.CantRollBackHeadChanged}
+	}
+
+	for _, branch := range changedBranches(before, after) {
+		if after.Branches[branch] != current.Branches[branch] {
+			return &types.DisabledReason{Text: utils.ResolvePlaceholderString(
+				self.c.Tr.CantRollBackBranchChanged,
+				map[string]string{"branch": branch},
+			)}
+		}
+	}
+
+	return nil
+}
+
+func (self *OperationHistoryHelper) describeChanges(before *models.RefsSnapshot, after *models.RefsSnapshot) []string {
+	changes := []string{}
+
+	if headChanged(before, after) {
+		changes = append(changes, utils.ResolvePlaceholderString(
+			self.c.Tr.OperationCheckedOut,
+			map[string]string{"from": describeHead(before), "to": describeHead(after)},
+		))
+	}
+
+	for _, branch := range changedBranches(before, after) {
+		beforeHash, existedBefore := before.Branches[branch]
+		afterHash, existsAfter := after.Branches[branch]
+		template := self.c.Tr.OperationBranchMoved
+		if !existedBefore {
+			template = self.c.Tr.OperationBranchCreated
+		} else if !existsAfter {
+			template = self.c.Tr.OperationBranchDeleted
+		}
+
+		changes = append(changes, utils.ResolvePlaceholderString(
+			template,
+			map[string]string{
+				"branch": branch,
+				"from":   utils.ShortHash(beforeHash),
+				"to":     utils.ShortHash(afterHash),
+			},
+		))
+	}
+
+	return changes
+}
+
+func hasRefChanges(before *models.RefsSnapshot, after *models.RefsSnapshot) bool {
+	return headChanged(before, after) || len(changedBranches(before, after)) > 0
+}
+
+// Whether a different branch (or, with a detached head, a different commit)
+// is checked out. Moving the checked out branch doesn't count; that shows up
+// as a change of the branch itself.
+func headChanged(before *models.RefsSnapshot, after *models.RefsSnapshot) bool {
+	if before.CheckedOutBranch != after.CheckedOutBranch {
+		return true
+	}
+
+	return before.CheckedOutBranch == "" && before.Head != after.Head
+}
+
+// Returns the names of all branches that were created, deleted, or moved,
+// sorted by name
+func changedBranches(before *models.RefsSnapshot, after *models.RefsSnapshot) []string {
+	names := lo.Uniq(append(lo.Keys(before.Branches), lo.Keys(after.Branches)...))
+	names = lo.Filter(names, func(name string, _ int) bool {
+		beforeHash, existedBefore := before.Branches[name]
+		afterHash, existsAfter := after.Branches[name]
+		return existedBefore != existsAfter || beforeHash != afterHash
+	})
+	slices.Sort(names)
+	return names
+}
+
+func describeHead(snapshot *models.RefsSnapshot) string {
+	if snapshot.CheckedOutBranch != "" {
+		return snapshot.CheckedOutBranch
+	}
+
+	return utils.ShortHash(snapshot.Head)
+}
@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationRefChanges(t *testing.T) {
+	scenarios := []struct {
+		name                    string
+		before                  *models.RefsSnapshot
+		after                   *models.RefsSnapshot
+		expectedChangedBranches []string
+		expectedHeadChanged     bool
+	}{
+		{
+			name:                    "nothing changed",
+			before:                  mkRefsSnapshot("master", "", "master", "aaa", "feature", "bbb"),
+			after:                   mkRefsSnapshot("master", "", "master", "aaa", "feature", "bbb"),
+			expectedChangedBranches: []string{},
+			expectedHeadChanged:     false,
+		},
+		{
+			name:                    "checked out branch moved",
+			before:                  mkRefsSnapshot("master", "", "master", "aaa"),
+			after:                   mkRefsSnapshot("master", "", "master", "bbb"),
+			expectedChangedBranches: []string{"master"},
+			expectedHeadChanged:     false,
+		},
+		{
+			name:                    "branch created and checked out, another one deleted",
+			before:                  mkRefsSnapshot("master", "", "master", "aaa", "old", "bbb"),
+			after:                   mkRefsSnapshot("new", "", "master", "aaa", "new", "aaa"),
+			expectedChangedBranches: []string{"new", "old"},
+			expectedHeadChanged:     true,
+		},
+		{
+			name:                    "detached head moved",
+			before:                  mkRefsSnapshot("", "aaa", "master", "aaa"),
+			after:                   mkRefsSnapshot("", "bbb", "master", "aaa"),
+			expectedChangedBranches: []string{},
+			expectedHeadChanged:     true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expectedChangedBranches, changedBranches(s.before, s.after))
+			assert.Equal(t, s.expectedHeadChanged, headChanged(s.before, s.after))
+			assert.Equal(t, len(s.expectedChangedBranches) > 0 || s.expectedHeadChanged, hasRefChanges(s.before, s.after))
+		})
+	}
+}
+
+// Takes alternating branch names and hashes. If checkedOutBranch is given, the
+// head is taken from the branches.
+func mkRefsSnapshot(checkedOutBranch string, head string, branchesAndHashes ...string) *models.RefsSnapshot {
+	branches := map[string]string{}
+	for i := 0; i < len(branchesAndHashes); i += 2 {
+		branches[branchesAndHashes[i]] = branchesAndHashes[i+1]
+	}
+
+	if checkedOutBranch != "" {
+		head = branches[checkedOutBranch]
+	}
+
+	return &models.RefsSnapshot{CheckedOutBranch: checkedOutBranch, Head: head, Branches: branches}
+}
@@ -0,0 +1,423 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// Branches are refreshed after pretty much every action, but we don't want to
+// hit the hosting service's API that often (it's slow, and rate limited)
+const minPullRequestsReloadInterval = time.Minute
+
+// PullRequestsHelper loads the open pull requests of the local branches in the
+// background, so that they can be shown in the branches panel, and creates
+// new pull requests through the hosting service's API
+type PullRequestsHelper struct {
+	c                 *HelperCommon
+	hostHelper        *HostHelper
+	commitsHelper     *CommitsHelper
+	suggestionsHelper *SuggestionsHelper
+
+	mutex        deadlock.Mutex
+	loading      bool
+	lastLoadTime time.Time
+}
+
+func NewPullRequestsHelper(
+	c *HelperCommon,
+	hostHelper *HostHelper,
+	commitsHelper *CommitsHelper,
+	suggestionsHelper *SuggestionsHelper,
+) *PullRequestsHelper {
+	return &PullRequestsHelper{
+		c:                 c,
+		hostHelper:        hostHelper,
+		commitsHelper:     commitsHelper,
+		suggestionsHelper: suggestionsHelper,
+	}
+}
+
+// LoadIfStale reloads the pull requests unless we've done so recently
+func (self *PullRequestsHelper) LoadIfStale() {
+	self.mutex.Lock()
+	stale := time.Since(self.lastLoadTime) >= minPullRequestsReloadInterval
+	self.mutex.Unlock()
+
+	if stale {
+		self.Load()
+	}
+}
+
+// Load reloads the pull requests in the background, unless they're already
+// being loaded
+func (self *PullRequestsHelper) Load() {
+	if !self.c.UserConfig().Git.PullRequests.ShowInBranchesPanel {
+		return
+	}
+
+	self.mutex.Lock()
+	if self.loading {
+		self.mutex.Unlock()
+		return
+	}
+	self.loading = true
+	self.mutex.Unlock()
+
+	// The branches can change while we're talking to the API, so we take a
+	// snapshot of them on the UI thread first
+	self.c.OnUIThread(func() error {
+		headBranches := pullRequestHeadBranches(self.c.Model().Branches)
+
+		self.c.OnWorker(func(_ gocui.Task) error {
+			pullRequests, err := self.load(headBranches)
+
+			self.mutex.Lock()
+			self.loading = false
+			self.lastLoadTime = time.Now()
+			self.mutex.Unlock()
+
+			if err != nil {
+				// This happens in the background, so we don't want to bother the
+				// user with an error popup (e.g. when they're offline)
+				self.c.Log.Warnf("Could not load pull requests: %v", err)
+				return nil
+			}
+
+			self.c.OnUIThread(func() error {
+				self.c.Model().PullRequests = pullRequests
+				self.c.Contexts().Branches.HandleRender()
+				return nil
+			})
+			return nil
+		})
+		return nil
+	})
+}
+
+// Returns the name that each local branch has on origin (i.e. the head branch
+// that its pull request would have), keyed by the local branch name
+func pullRequestHeadBranches(branches []*models.Branch) map[string]string {
+	return lo.SliceToMap(branches, func(branch *models.Branch) (string, string) {
+		if branch.IsTrackingRemote() && branch.UpstreamRemote == "origin" {
+			return branch.Name, branch.UpstreamBranch
+		}
+		return branch.Name, branch.Name
+	})
+}
+
+// Takes the head branches of the local branches, keyed by local branch name,
+// and returns their pull requests, keyed the same way
+func (self *PullRequestsHelper) load(headBranches map[string]string) (map[string]*models.PullRequest, error) {
+	client, err := self.hostHelper.GetAPIClient()
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequests, err := client.GetOpenPullRequests()
+	if err != nil {
+		return nil, err
+	}
+
+	// Pull requests from forks can have the same head branch name as one of
+	// ours, so we ignore those. If there are several pull requests for the
+	// same branch, the first one (i.e. the most recently updated) wins.
+	byHeadBranch := map[string]*models.PullRequest{}
+	for _, pr := range pullRequests {
+		if _, ok := byHeadBranch[pr.HeadBranch]; !ok && !pr.IsCrossRepository {
+			byHeadBranch[pr.HeadBranch] = pr
+		}
+	}
+
+	result := map[string]*models.PullRequest{}
+	for branchName, headBranch := range headBranches {
+		if pr, ok := byHeadBranch[headBranch]; ok {
+			result[branchName] = pr
+		}
+	}
+
+	// If we can't get the status of a pull request we still show the pull
+	// request itself, just without its status
+	wg := sync.WaitGroup{}
+	for _, pr := range lo.Uniq(lo.Values(result)) {
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+			if err := client.LoadPullRequestStatus(pr); err != nil {
+				self.c.Log.Warnf("Could not load status of pull request #%d: %v", pr.Number, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	return result, nil
+}
+
+// Create asks for the base branch, title, description and other options of a
+// new pull request for the given branch, and then creates it.
+func (self *PullRequestsHelper) Create(branch *models.Branch) error {
+	return self.c.WithWaitingStatus(self.c.Tr.PreparingPullRequestStatus, func(gocui.Task) error {
+		client, err := self.hostHelper.GetAPIClient()
+		if err != nil {
+			return err
+		}
+
+		defaultBranch, err := client.GetDefaultBranch()
+		if err != nil {
+			return err
+		}
+
+		headOwner, err := self.pullRequestHeadOwner(branch)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			self.promptForBaseBranch(client, branch, defaultBranch, headOwner)
+			return nil
+		})
+		return nil
+	})
+}
+
+// A pull request for a branch that's on a fork needs to say whose fork it is;
+// returns an empty string if the branch is (or will be pushed) on origin
+func (self *PullRequestsHelper) pullRequestHeadOwner(branch *models.Branch) (string, error) {
+	if !branch.IsTrackingRemote() || branch.UpstreamRemote == "origin" {
+		return "", nil
+	}
+
+	headOwner, err := self.hostHelper.GetRepoOwner(branch.UpstreamRemote)
+	if err != nil {
+		return "", err
+	}
+
+	// The remote might just be another name for origin's repo
+	owner, err := self.hostHelper.GetRepoOwner("origin")
+	if err != nil {
+		return "", err
+	}
+
+	return lo.Ternary(headOwner == owner, "", headOwner), nil
+}
+
+func (self *PullRequestsHelper) promptForBaseBranch(client *hosting_service.APIClient, branch *models.Branch, defaultBranch string, headOwner string) {
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.PullRequestBaseBranchTitle,
+			map[string]string{"branch": branch.Name},
+		),
+		InitialContent:      defaultBranch,
+		FindSuggestionsFunc: self.suggestionsHelper.GetRemoteBranchesForRemoteSuggestionsFunc("origin"),
+		HandleConfirm: func(baseBranch string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.PreparingPullRequestStatus, func(gocui.Task) error {
+				title, body := self.defaultTitleAndBody(branch, baseBranch)
+				opts := &hosting_service.CreatePullRequestOpts{
+					HeadBranch: pullRequestHeadBranch(branch),
+					HeadOwner:  headOwner,
+					BaseBranch: baseBranch,
+					Title:      title,
+					Body:       body,
+				}
+
+				self.c.OnUIThread(func() error {
+					self.editTitleAndBody(client, branch, opts)
+					return nil
+				})
+				return nil
+			})
+		},
+	})
+}
+
+// Pre-fills the title and body from the commits that the branch has on top of
+// the base branch
+func (self *PullRequestsHelper) defaultTitleAndBody(branch *models.Branch, baseBranch string) (string, string) {
+	messages, err := self.c.Git().Commit.GetCommitMessagesBetween("origin/"+baseBranch, branch.FullRefName())
+	if err != nil {
+		// Most likely the base branch hasn't been fetched
+		self.c.Log.Warnf("Could not get commits of branch %s: %v", branch.Name, err)
+	}
+
+	return pullRequestTitleAndBody(branch.Name, messages)
+}
+
+// With a single commit we use its message, like the hosting services do.
+// Otherwise we use the branch name as the title and list the commits in the
+// body.
+func pullRequestTitleAndBody(branchName string, commitMessages []string) (string, string) {
+	if len(commitMessages) == 1 {
+		title, body, _ := strings.Cut(commitMessages[0], "\n")
+		return title, strings.TrimSpace(body)
+	}
+
+	subjects := lo.Map(commitMessages, func(message string, _ int) string {
+		subject, _, _ := strings.Cut(message, "\n")
+		return "- " + subject
+	})
+	return branchName, strings.Join(subjects, "\n")
+}
+
+func (self *PullRequestsHelper) editTitleAndBody(client *hosting_service.APIClient, branch *models.Branch, opts *hosting_service.CreatePullRequestOpts) {
+	initialMessage := opts.Title
+	if opts.Body != "" {
+		initialMessage += "\n" + opts.Body
+	}
+
+	self.commitsHelper.OpenCommitMessagePanel(
+		&OpenCommitMessagePanelOpts{
+			CommitIndex:      context.NoCommitIndex,
+			InitialMessage:   initialMessage,
+			SummaryTitle:     self.c.Tr.PullRequestTitleTitle,
+			DescriptionTitle: self.c.Tr.PullRequestDescriptionTitle,
+			PreserveMessage:  false,
+			DisableAutoWrap:  true,
+			OnConfirm: func(title string, body string) error {
+				opts.Title = title
+				opts.Body = body
+				return self.openOptionsMenu(client, branch, opts)
+			},
+		},
+	)
+}
+
+func (self *PullRequestsHelper) openOptionsMenu(client *hosting_service.APIClient, branch *models.Branch, opts *hosting_service.CreatePullRequestOpts) error {
+	listOrNone := func(items []string) string {
+		if len(items) == 0 {
+			return self.c.Tr.PullRequestOptionNone
+		}
+		return strings.Join(items, ", ")
+	}
+
+	reopen := func() error {
+		return self.openOptionsMenu(client, branch, opts)
+	}
+
+	promptForList := func(title string, items *[]string) error {
+		self.c.Prompt(types.PromptOpts{
+			Title:          title,
+			InitialContent: strings.Join(*items, ", "),
+			HandleConfirm: func(value string) error {
+				*items = splitCommaSeparated(value)
+				return reopen()
+			},
+		})
+		return nil
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.CreatePullRequest, fmt.Sprintf("%s → %s", opts.QualifiedHeadBranch(), opts.BaseBranch)},
+			OnPress: func() error {
+				return self.create(client, branch, *opts)
+			},
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PullRequestTitleOption, opts.Title},
+			OnPress: func() error {
+				self.editTitleAndBody(client, branch, opts)
+				return nil
+			},
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PullRequestReviewersOption, listOrNone(opts.Reviewers)},
+			OnPress: func() error {
+				return promptForList(self.c.Tr.PullRequestReviewersPrompt, &opts.Reviewers)
+			},
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PullRequestLabelsOption, listOrNone(opts.Labels)},
+			OnPress: func() error {
+				return promptForList(self.c.Tr.PullRequestLabelsPrompt, &opts.Labels)
+			},
+		},
+		{
+			LabelColumns: []string{self.c.Tr.PullRequestDraftOption, lo.Ternary(opts.IsDraft, self.c.Tr.PullRequestOptionYes, self.c.Tr.PullRequestOptionNo)},
+			OnPress: func() error {
+				opts.IsDraft = !opts.IsDraft
+				return reopen()
+			},
+		},
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CreatePullRequest,
+		Items: menuItems,
+	})
+}
+
+func (self *PullRequestsHelper) create(client *hosting_service.APIClient, branch *models.Branch, opts hosting_service.CreatePullRequestOpts) error {
+	self.c.LogAction(self.c.Tr.Actions.CreatePullRequest)
+
+	return self.c.WithWaitingStatus(self.c.Tr.CreatingPullRequestStatus, func(task gocui.Task) error {
+		if err := self.pushIfNeeded(task, branch); err != nil {
+			return err
+		}
+
+		pr, err := client.CreatePullRequest(opts)
+		if pr != nil {
+			self.c.Toast(utils.ResolvePlaceholderString(
+				self.c.Tr.PullRequestCreated,
+				map[string]string{"number": fmt.Sprint(pr.Number)},
+			))
+			self.Load()
+		}
+
+		refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+		if err != nil {
+			return err
+		}
+		return refreshErr
+	})
+}
+
+// The branch needs to be on the remote before we can create a pull request
+// for it
+func (self *PullRequestsHelper) pushIfNeeded(task gocui.Task, branch *models.Branch) error {
+	if branch.IsTrackingRemote() && !branch.RemoteBranchNotStoredLocally() && branch.AheadForPull == "0" {
+		return nil
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.Push)
+
+	opts := git_commands.PushOpts{
+		CurrentBranch:  branch.Name,
+		UpstreamRemote: branch.UpstreamRemote,
+		UpstreamBranch: branch.UpstreamBranch,
+	}
+	if !branch.IsTrackingRemote() {
+		opts.UpstreamRemote = "origin"
+		opts.UpstreamBranch = branch.Name
+		opts.SetUpstream = true
+	}
+
+	return self.c.Git().Sync.Push(task, opts)
+}
+
+// The name of the branch on the remote, which is what the hosting service
+// knows it as
+func pullRequestHeadBranch(branch *models.Branch) string {
+	if branch.IsTrackingRemote() {
+		return branch.UpstreamBranch
+	}
+
+	return branch.Name
+}
+
+func splitCommaSeparated(value string) []string {
+	items := lo.Map(strings.Split(value, ","), func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	})
+	return lo.Filter(items, func(item string, _ int) bool { return item != "" })
+}
@@ -0,0 +1,65 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestTitleAndBody(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		commitMessages []string
+		expectedTitle  string
+		expectedBody   string
+	}{
+		{
+			name:           "no commits",
+			commitMessages: []string{},
+			expectedTitle:  "feature/login",
+			expectedBody:   "",
+		},
+		{
+			name:           "single commit without body",
+			commitMessages: []string{"Add login page"},
+			expectedTitle:  "Add login page",
+			expectedBody:   "",
+		},
+		{
+			name:           "single commit with body",
+			commitMessages: []string{"Add login page\n\nIt has a form.\nAnd a button."},
+			expectedTitle:  "Add login page",
+			expectedBody:   "It has a form.\nAnd a button.",
+		},
+		{
+			name:           "several commits",
+			commitMessages: []string{"Add login page\n\nIt has a form.", "Add logout button"},
+			expectedTitle:  "feature/login",
+			expectedBody:   "- Add login page\n- Add logout button",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			title, body := pullRequestTitleAndBody("feature/login", s.commitMessages)
+			assert.Equal(t, s.expectedTitle, title)
+			assert.Equal(t, s.expectedBody, body)
+		})
+	}
+}
+
+func TestPullRequestHeadBranches(t *testing.T) {
+	branches := []*models.Branch{
+		{Name: "untracked"},
+		{Name: "renamed", UpstreamRemote: "origin", UpstreamBranch: "feature/renamed"},
+		{Name: "fork", UpstreamRemote: "fork", UpstreamBranch: "feature/fork"},
+	}
+
+	assert.Equal(t, map[string]string{
+		"untracked": "untracked",
+		"renamed":   "feature/renamed",
+		"fork":      "fork",
+	}, pullRequestHeadBranches(branches))
+}
@@ -28,6 +28,8 @@ type RefreshHelper struct {
 	mergeConflictsHelper *MergeConflictsHelper
 	worktreeHelper       *WorktreeHelper
 	searchHelper         *SearchHelper
+	pullRequestsHelper   *PullRequestsHelper
+	tagsHelper           *TagsHelper
 }
 
 func NewRefreshHelper(
@@ -39,6 +41,8 @@ func NewRefreshHelper(
 	mergeConflictsHelper *MergeConflictsHelper,
 	worktreeHelper *WorktreeHelper,
 	searchHelper *SearchHelper,
+	pullRequestsHelper *PullRequestsHelper,
+	tagsHelper *TagsHelper,
 ) *RefreshHelper {
 	return &RefreshHelper{
 		c:                    c,
@@ -49,6 +53,8 @@ func NewRefreshHelper(
 		mergeConflictsHelper: mergeConflictsHelper,
 		worktreeHelper:       worktreeHelper,
 		searchHelper:         searchHelper,
+		pullRequestsHelper:   pullRequestsHelper,
+		tagsHelper:           tagsHelper,
 	}
 }
 
@@ -92,6 +98,7 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) error {
 				types.STATUS,
 				types.BISECT_INFO,
 				types.STAGING,
+				types.SPARSE_CHECKOUT,
 			})
 		} else {
 			scopeSet = set.NewFromSlice(options.Scope)
@@ -156,6 +163,10 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) error {
 			})
 		}
 
+		if scopeSet.Includes(types.SPARSE_CHECKOUT) {
+			refresh("sparse checkout", func() { self.refreshSparseCheckout() })
+		}
+
 		if scopeSet.Includes(types.STASH) {
 			refresh("stash", func() { self.refreshStashEntries() })
 		}
@@ -226,6 +237,7 @@ func getScopeNames(scopes []types.RefreshableView) []string {
 		types.BISECT_INFO:     "bisect",
 		types.STAGING:         "staging",
 		types.MERGE_CONFLICTS: "mergeConflicts",
+		types.SPARSE_CHECKOUT: "sparseCheckout",
 	}
 
 	return lo.Map(scopes, func(scope types.RefreshableView, _ int) string {
@@ -427,6 +439,8 @@ func (self *RefreshHelper) refreshTags() error {
 	self.c.Model().Tags = tags
 
 	self.refreshView(self.c.Contexts().Tags)
+
+	self.tagsHelper.LoadRemoteTagsIfStale()
 	return nil
 }
 
@@ -502,6 +516,8 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 
 	self.refreshView(self.c.Contexts().Branches)
 
+	self.pullRequestsHelper.LoadIfStale()
+
 	// Need to re-render the commits view because the visualization of local
 	// branch heads might have changed
 	self.c.Mutexes().LocalCommitsMutex.Lock()
@@ -536,6 +552,19 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 	return nil
 }
 
+func (self *RefreshHelper) refreshSparseCheckout() {
+	sparseCheckout := self.c.Git().SparseCheckout.Get()
+
+	self.c.OnUIThread(func() error {
+		self.c.Model().SparseCheckout = sparseCheckout
+		// the files panel marks the directories outside the sparse-checkout
+		// cone, and the status panel shows whether the worktree is sparse
+		self.refreshView(self.c.Contexts().Files)
+		self.refreshStatus()
+		return nil
+	})
+}
+
 func (self *RefreshHelper) refreshStateFiles() error {
 	fileTreeViewModel := self.c.Contexts().Files.FileTreeViewModel
 
@@ -553,7 +582,9 @@ func (self *RefreshHelper) refreshStateFiles() error {
 			if file.HasMergeConflicts {
 				prevConflictFileCount++
 			}
-			if file.HasInlineMergeConflicts {
+			// Files resolved by rerere aren't staged so that the user gets a
+			// chance to review the recorded resolution first.
+			if file.HasInlineMergeConflicts && !file.ResolvedByRerere {
 				hasConflicts, err := mergeconflicts.FileHasConflictMarkers(file.Name)
 				if err != nil {
 					self.c.Log.Error(err)
@@ -687,7 +718,29 @@ func (self *RefreshHelper) loadWorktrees() {
 		self.c.Model().Worktrees = []*models.Worktree{}
 	}
 
+	// If the worktree already existed, take over its status until the new one
+	// is loaded, to reduce flicker
+	for _, worktree := range worktrees {
+		if oldWorktree, found := lo.Find(self.c.Model().Worktrees, func(w *models.Worktree) bool {
+			return w.Path == worktree.Path
+		}); found {
+			worktree.Status.Store(oldWorktree.Status.Load())
+		}
+	}
+
 	self.c.Model().Worktrees = worktrees
+
+	self.c.OnWorker(func(_ gocui.Task) error {
+		return self.c.Git().Loaders.Worktrees.LoadStatuses(worktrees, func() {
+			self.c.OnUIThread(func() error {
+				self.c.Contexts().Worktrees.HandleRender()
+				if self.c.Context().CurrentSide() == self.c.Contexts().Worktrees {
+					self.c.Contexts().Worktrees.HandleRenderToMain()
+				}
+				return nil
+			})
+		})
+	})
 }
 
 func (self *RefreshHelper) refreshWorktrees() {
@@ -721,8 +774,9 @@ func (self *RefreshHelper) refreshStatus() {
 	linkedWorktreeName := self.worktreeHelper.GetLinkedWorktreeName()
 
 	repoName := self.c.Git().RepoPaths.RepoName()
+	isSparseCheckout := self.c.Model().SparseCheckout != nil
 
-	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, self.c.Tr, self.c.UserConfig())
+	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, isSparseCheckout, self.c.Tr, self.c.UserConfig())
 
 	self.c.SetViewContent(self.c.Views().Status, status)
 }
@@ -24,14 +24,17 @@ type IRefsHelper interface {
 }
 
 type RefsHelper struct {
-	c *HelperCommon
+	c                      *HelperCommon
+	operationHistoryHelper *OperationHistoryHelper
 }
 
 func NewRefsHelper(
 	c *HelperCommon,
+	operationHistoryHelper *OperationHistoryHelper,
 ) *RefsHelper {
 	return &RefsHelper{
-		c: c,
+		c:                      c,
+		operationHistoryHelper: operationHistoryHelper,
 	}
 }
 
@@ -67,8 +70,15 @@ func (self *RefsHelper) CheckoutRef(ref string, options types.CheckoutRefOptions
 		}
 	}
 
+	action := fmt.Sprintf("%s %s", self.c.Tr.Checkout, ref)
+	checkout := func() error {
+		return self.operationHistoryHelper.Track(action, func() error {
+			return self.c.Git().Branch.Checkout(ref, cmdOptions)
+		})
+	}
+
 	return withCheckoutStatus(func(gocui.Task) error {
-		if err := self.c.Git().Branch.Checkout(ref, cmdOptions); err != nil {
+		if err := checkout(); err != nil {
 			// note, this will only work for english-language git commands. If we force git to use english, and the error isn't this one, then the user will receive an english command they may not understand. I'm not sure what the best solution to this is. Running the command once in english and a second time in the native language is one option
 
 			if options.OnRefNotFound != nil && strings.Contains(err.Error(), "did not match any file(s) known to git") {
@@ -88,7 +98,7 @@ func (self *RefsHelper) CheckoutRef(ref string, options types.CheckoutRefOptions
 								if err := self.c.Git().Stash.Push(self.c.Tr.StashPrefix + ref); err != nil {
 									return err
 								}
-								if err := self.c.Git().Branch.Checkout(ref, cmdOptions); err != nil {
+								if err := checkout(); err != nil {
 									return err
 								}
 								err := self.c.Git().Stash.Pop(0)
@@ -196,6 +206,65 @@ func (self *RefsHelper) ResetToRef(ref string, strength string, envVars []string
 	return nil
 }
 
+// Shows the reflog of the given branch (a local or a remote-tracking one) in
+// place of the current side panel.
+func (self *RefsHelper) ViewBranchReflog(ref types.Ref) error {
+	commits, err := self.c.Git().Loaders.ReflogCommitLoader.GetBranchReflogCommits(ref.FullRefName())
+	if err != nil {
+		return err
+	}
+
+	parentContext := self.c.Context().CurrentSide()
+	branchReflogContext := self.c.Contexts().BranchReflog
+	branchReflogContext.ClearFilter()
+	branchReflogContext.SetBranchReflog(ref, commits)
+	branchReflogContext.SetSelection(0)
+	branchReflogContext.SetParentContext(parentContext)
+	branchReflogContext.SetWindowName(parentContext.GetWindowName())
+	branchReflogContext.SetTitleRef(utils.TruncateWithEllipsis(ref.RefName(), 50))
+	branchReflogContext.GetView().TitlePrefix = parentContext.GetView().TitlePrefix
+
+	self.c.PostRefreshUpdate(branchReflogContext)
+
+	self.c.Context().Push(branchReflogContext)
+	return nil
+}
+
+// Moves the branch whose reflog is shown to the given reflog entry. If the
+// branch is checked out we reset it with --keep so that uncommitted changes
+// survive; the move can then be undone like any other reset.
+func (self *RefsHelper) RestoreBranchFromReflog(branch *models.Branch, entry *models.Commit) error {
+	if branch.Head {
+		if err := self.ResetToRef(entry.Hash, "keep", []string{}); err != nil {
+			return err
+		}
+	} else {
+		if err := self.c.Git().Branch.MoveTo(branch.Name, entry.Hash); err != nil {
+			return err
+		}
+		if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES}}); err != nil {
+			return err
+		}
+	}
+
+	return self.reloadBranchReflog()
+}
+
+func (self *RefsHelper) reloadBranchReflog() error {
+	branchReflogContext := self.c.Contexts().BranchReflog
+	ref := branchReflogContext.GetRef()
+	commits, err := self.c.Git().Loaders.ReflogCommitLoader.GetBranchReflogCommits(ref.FullRefName())
+	if err != nil {
+		return err
+	}
+
+	branchReflogContext.SetBranchReflog(ref, commits)
+	branchReflogContext.ReApplyFilter(self.c.UserConfig().Gui.UseFuzzySearch())
+	branchReflogContext.SetSelection(0)
+	self.c.PostRefreshUpdate(branchReflogContext)
+	return nil
+}
+
 func (self *RefsHelper) CreateSortOrderMenu(sortOptionsOrder []string, onSelected func(sortOrder string) error, currentValue string) error {
 	type sortMenuOption struct {
 		key         types.Key
@@ -1,25 +1,144 @@
 package helpers
 
 import (
+	"strings"
+	"time"
+
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
 )
 
+// Asking the remote for its tags is a network round trip, so we don't do it
+// every time the tags are refreshed
+const minRemoteTagsReloadInterval = time.Minute
+
+// The pre-release we suggest when the latest release tag isn't a pre-release
+// already, e.g. v1.2.4-rc.1 after v1.2.3
+const releaseTagPreReleaseIdentifier = "rc"
+
 type TagsHelper struct {
-	c             *HelperCommon
-	commitsHelper *CommitsHelper
+	c              *HelperCommon
+	commitsHelper  *CommitsHelper
+	upstreamHelper *UpstreamHelper
+
+	mutex        deadlock.Mutex
+	loading      bool
+	lastLoadTime time.Time
+	// The remote whose tags we loaded last, whether that succeeded or not
+	loadedRemote string
 }
 
-func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper) *TagsHelper {
+func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper, upstreamHelper *UpstreamHelper) *TagsHelper {
 	return &TagsHelper{
-		c:             c,
-		commitsHelper: commitsHelper,
+		c:              c,
+		commitsHelper:  commitsHelper,
+		upstreamHelper: upstreamHelper,
 	}
 }
 
 func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
+	self.openCreateTagPanel(ref, "")
+	return nil
+}
+
+// OpenCreateReleaseTagMenu suggests the versions that could follow the latest
+// release tag, and then lets the user edit the tag's drafted message
+func (self *TagsHelper) OpenCreateReleaseTagMenu(ref string) error {
+	prefix := self.c.UserConfig().Git.ReleaseTagPrefix
+	tagNames := lo.Map(self.c.Model().Tags, func(tag *models.Tag, _ int) string {
+		return tag.Name
+	})
+	// If there are no release tags yet, we start from 0.0.0
+	latest, latestTagName, _ := utils.LatestSemVer(tagNames, prefix)
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+		commits, err := self.commitsSinceTag(latestTagName, ref)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.openCreateReleaseTagMenu(ref, prefix, latest, latestTagName, commits)
+		})
+		return nil
+	})
+}
+
+func (self *TagsHelper) openCreateReleaseTagMenu(ref string, prefix string, latest utils.SemVer, latestTagName string, commits string) error {
+	tooltip := self.releaseTagTooltip(prefix, latestTagName, commits)
+	menuItem := func(label string, version utils.SemVer) *types.MenuItem {
+		tagName := prefix + version.String()
+		return &types.MenuItem{
+			LabelColumns: []string{label, style.FgYellow.Sprint(tagName)},
+			OnPress: func() error {
+				self.openCreateTagPanel(ref, tagName+"\n"+releaseTagMessage(tagName, latestTagName, commits))
+				return nil
+			},
+			Tooltip: tooltip,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CreateReleaseTag,
+		Items: []*types.MenuItem{
+			menuItem(self.c.Tr.NextPatchVersion, latest.NextPatch()),
+			menuItem(self.c.Tr.NextMinorVersion, latest.NextMinor()),
+			menuItem(self.c.Tr.NextMajorVersion, latest.NextMajor()),
+			menuItem(self.c.Tr.NextPreReleaseVersion, latest.NextPreRelease(releaseTagPreReleaseIdentifier)),
+		},
+	})
+}
+
+// Returns the `git log --oneline` output of the non-merge commits reachable
+// from ref but not from the given tag, oldest first
+func (self *TagsHelper) commitsSinceTag(tagName string, ref string) (string, error) {
+	if tagName == "" {
+		return "", nil
+	}
+
+	if ref == "" {
+		ref = "HEAD"
+	}
+	return self.c.Git().Commit.GetCommitsOnelineBetween("refs/tags/"+tagName, ref)
+}
+
+func (self *TagsHelper) releaseTagTooltip(prefix string, latestTagName string, commits string) string {
+	if latestTagName == "" {
+		return utils.ResolvePlaceholderString(self.c.Tr.NoReleaseTags, map[string]string{"prefix": prefix})
+	}
+
+	if commits == "" {
+		return utils.ResolvePlaceholderString(self.c.Tr.NoCommitsSinceReleaseTag, map[string]string{"tagName": latestTagName})
+	}
+
+	return utils.ResolvePlaceholderString(self.c.Tr.CommitsSinceReleaseTag, map[string]string{"tagName": latestTagName}) +
+		"\n\n" + strings.TrimSpace(commits)
+}
+
+// Drafts the message of an annotated release tag, listing the commits since
+// the previous release
+func releaseTagMessage(tagName string, previousTagName string, commits string) string {
+	message := "Release " + tagName
+
+	lines := utils.SplitLines(commits)
+	if len(lines) == 0 {
+		return message
+	}
+
+	message += "\n\nChanges since " + previousTagName + ":\n"
+	for _, line := range lines {
+		message += "\n- " + line
+	}
+	return message
+}
+
+func (self *TagsHelper) openCreateTagPanel(ref string, initialMessage string) {
 	doCreateTag := func(tagName string, description string, force bool) error {
 		return self.c.WithWaitingStatus(self.c.Tr.CreatingTag, func(gocui.Task) error {
 			if description != "" {
@@ -69,13 +188,100 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 	self.commitsHelper.OpenCommitMessagePanel(
 		&OpenCommitMessagePanelOpts{
 			CommitIndex:      context.NoCommitIndex,
-			InitialMessage:   "",
+			InitialMessage:   initialMessage,
 			SummaryTitle:     self.c.Tr.TagNameTitle,
 			DescriptionTitle: self.c.Tr.TagMessageTitle,
 			PreserveMessage:  false,
 			OnConfirm:        onConfirm,
 		},
 	)
+}
 
-	return nil
+// RemoteForTags returns the remote whose tags we compare ours against, which
+// is the one that the checked out branch tracks
+func (self *TagsHelper) RemoteForTags() string {
+	return self.upstreamHelper.GetSuggestedRemoteForCheckedOutBranch()
+}
+
+// LoadRemoteTagsIfStale reloads the remote's tags in the background, unless
+// we've done so recently. Like the background fetch, this only happens if
+// git.autoFetch is enabled.
+func (self *TagsHelper) LoadRemoteTagsIfStale() {
+	if !self.c.UserConfig().Git.AutoFetch {
+		return
+	}
+
+	remote := self.RemoteForTags()
+	self.mutex.Lock()
+	stale := remote != self.loadedRemote || time.Since(self.lastLoadTime) >= minRemoteTagsReloadInterval
+	self.mutex.Unlock()
+
+	if stale {
+		self.loadRemoteTags(remote)
+	}
+}
+
+// LoadRemoteTagsIfNotLoaded loads the remote's tags in the background if we
+// haven't done so yet, so that the tags panel can show which tags are missing
+// on the remote even if git.autoFetch is disabled
+func (self *TagsHelper) LoadRemoteTagsIfNotLoaded() {
+	remote := self.RemoteForTags()
+	self.mutex.Lock()
+	loaded := remote == self.loadedRemote
+	self.mutex.Unlock()
+
+	if !loaded {
+		self.loadRemoteTags(remote)
+	}
+}
+
+// LoadRemoteTags reloads the given remote's tags in the background if it's the
+// one we compare our tags against, e.g. after pushing tags to it
+func (self *TagsHelper) LoadRemoteTags(remote string) {
+	if remote == self.RemoteForTags() {
+		self.loadRemoteTags(remote)
+	}
+}
+
+func (self *TagsHelper) loadRemoteTags(remote string) {
+	self.mutex.Lock()
+	if self.loading {
+		self.mutex.Unlock()
+		return
+	}
+	self.loading = true
+	self.mutex.Unlock()
+
+	self.c.OnWorker(func(_ gocui.Task) error {
+		tagNames, err := self.c.Git().Tag.GetRemoteTagNames(remote)
+
+		self.mutex.Lock()
+		self.loading = false
+		self.lastLoadTime = time.Now()
+		self.loadedRemote = remote
+		self.mutex.Unlock()
+
+		if err != nil {
+			// E.g. there's no such remote, or we're offline
+			self.c.Log.Warnf("Could not load tags of remote %s: %v", remote, err)
+		}
+
+		self.c.OnUIThread(func() error {
+			if err != nil && self.c.Model().RemoteForTags == remote {
+				// Keep what we knew about this remote's tags
+				return nil
+			}
+
+			self.c.Model().RemoteForTags = remote
+			self.c.Model().RemoteTagNames = nil
+			if err == nil {
+				self.c.Model().RemoteTagNames = lo.SliceToMap(tagNames, func(name string) (string, bool) {
+					return name, true
+				})
+			}
+			self.c.PostRefreshUpdate(self.c.Contexts().Tags)
+			return nil
+		})
+		return nil
+	})
 }
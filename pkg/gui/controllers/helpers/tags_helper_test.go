@@ -0,0 +1,41 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseTagMessage(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		previousTagName string
+		commits         string
+		expected        string
+	}{
+		{
+			name:            "first release",
+			previousTagName: "",
+			commits:         "",
+			expected:        "Release v1.2.4",
+		},
+		{
+			name:            "no commits since the previous release",
+			previousTagName: "v1.2.3",
+			commits:         "",
+			expected:        "Release v1.2.4",
+		},
+		{
+			name:            "several commits",
+			previousTagName: "v1.2.3",
+			commits:         "abc1234 Fix crash\ndef5678 Add button\n",
+			expected:        "Release v1.2.4\n\nChanges since v1.2.3:\n\n- abc1234 Fix crash\n- def5678 Add button",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, releaseTagMessage("v1.2.4", s.previousTagName, s.commits))
+		})
+	}
+}
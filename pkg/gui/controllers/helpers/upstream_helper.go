@@ -6,6 +6,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type UpstreamHelper struct {
@@ -72,6 +73,25 @@ func (self *UpstreamHelper) GetSuggestedRemote() string {
 	return getSuggestedRemote(self.c.Model().Remotes)
 }
 
+// Suggests the remote that the checked out branch tracks, if any, for things
+// that aren't tied to a particular branch (like tags or notes); falls back to
+// GetSuggestedRemote otherwise
+func (self *UpstreamHelper) GetSuggestedRemoteForCheckedOutBranch() string {
+	return getSuggestedRemoteForCheckedOutBranch(self.c.Model().Branches, self.c.Model().Remotes)
+}
+
+func getSuggestedRemoteForCheckedOutBranch(branches []*models.Branch, remotes []*models.Remote) string {
+	if len(branches) > 0 && branches[0].Head {
+		// The upstream remote can also be a URL, or "." for a local branch
+		upstreamRemote := branches[0].UpstreamRemote
+		if lo.SomeBy(remotes, func(remote *models.Remote) bool { return remote.Name == upstreamRemote }) {
+			return upstreamRemote
+		}
+	}
+
+	return getSuggestedRemote(remotes)
+}
+
 func getSuggestedRemote(remotes []*models.Remote) string {
 	if len(remotes) == 0 {
 		return "origin"
@@ -24,6 +24,52 @@ func TestGetSuggestedRemote(t *testing.T) {
 	}
 }
 
+func TestGetSuggestedRemoteForCheckedOutBranch(t *testing.T) {
+	cases := []struct {
+		name     string
+		branches []*models.Branch
+		remotes  []*models.Remote
+		expected string
+	}{
+		{
+			name:     "checked out branch tracks a remote",
+			branches: []*models.Branch{{Name: "feature", Head: true, UpstreamRemote: "fork"}},
+			remotes:  mkRemoteList("origin", "fork"),
+			expected: "fork",
+		},
+		{
+			name:     "checked out branch has no upstream",
+			branches: []*models.Branch{{Name: "feature", Head: true}},
+			remotes:  mkRemoteList("upstream", "origin"),
+			expected: "origin",
+		},
+		{
+			name:     "checked out branch tracks a local branch",
+			branches: []*models.Branch{{Name: "feature", Head: true, UpstreamRemote: "."}},
+			remotes:  mkRemoteList("upstream"),
+			expected: "upstream",
+		},
+		{
+			name:     "detached head",
+			branches: []*models.Branch{{Name: "abc123", DetachedHead: true}, {Name: "feature", UpstreamRemote: "fork"}},
+			remotes:  mkRemoteList("origin", "fork"),
+			expected: "origin",
+		},
+		{
+			name:     "no branches yet",
+			branches: []*models.Branch{},
+			remotes:  mkRemoteList("fork"),
+			expected: "fork",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, getSuggestedRemoteForCheckedOutBranch(c.branches, c.remotes))
+		})
+	}
+}
+
 func mkRemoteList(names ...string) []*models.Remote {
 	return lo.Map(names, func(name string, _ int) *models.Remote {
 		return &models.Remote{Name: name}
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type IWorkingTreeHelper interface {
@@ -86,6 +87,92 @@ func (self *WorkingTreeHelper) OpenMergeTool() error {
 	return nil
 }
 
+// CreateMergeConflictMenu shows the options for resolving the merge conflicts
+// of the given files. Only the files that actually have merge conflicts are
+// acted upon.
+func (self *WorkingTreeHelper) CreateMergeConflictMenu(selectedFiles []*models.File) error {
+	conflictedFiles := lo.Filter(selectedFiles, func(file *models.File, _ int) bool {
+		return file.HasMergeConflicts
+	})
+
+	var disabledReason *types.DisabledReason
+	if len(conflictedFiles) == 0 {
+		disabledReason = &types.DisabledReason{Text: self.c.Tr.NoSelectedFilesWithMergeConflicts}
+	}
+
+	resolveItem := func(label string, tooltip string, key types.Key, version models.ConflictVersion) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				return self.resolveConflictsWithVersion(conflictedFiles, version)
+			},
+			Key:            key,
+			Tooltip:        tooltip,
+			DisabledReason: disabledReason,
+		}
+	}
+
+	rerereFiles := lo.Filter(conflictedFiles, func(file *models.File, _ int) bool {
+		return file.ResolvedByRerere
+	})
+	var forgetDisabledReason *types.DisabledReason
+	if len(rerereFiles) == 0 {
+		forgetDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoSelectedFilesResolvedByRerere}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.MergeConflictOptionsTitle,
+		Items: []*types.MenuItem{
+			resolveItem(self.c.Tr.UseOursVersion, self.c.Tr.UseOursVersionTooltip, 'o', models.CONFLICT_VERSION_OURS),
+			resolveItem(self.c.Tr.UseTheirsVersion, self.c.Tr.UseTheirsVersionTooltip, 't', models.CONFLICT_VERSION_THEIRS),
+			resolveItem(self.c.Tr.UseBaseVersion, self.c.Tr.UseBaseVersionTooltip, 'b', models.CONFLICT_VERSION_BASE),
+			{
+				Label:   self.c.Tr.OpenMergeTool,
+				OnPress: self.OpenMergeTool,
+				Key:     'm',
+				Tooltip: self.c.Tr.OpenMergeToolTooltip,
+			},
+			{
+				Label: self.c.Tr.ForgetRerereResolution,
+				OnPress: func() error {
+					return self.forgetRerereResolutions(rerereFiles)
+				},
+				Key:            'f',
+				Tooltip:        self.c.Tr.ForgetRerereResolutionTooltip,
+				DisabledReason: forgetDisabledReason,
+			},
+		},
+	})
+}
+
+func (self *WorkingTreeHelper) resolveConflictsWithVersion(files []*models.File, version models.ConflictVersion) error {
+	self.c.LogAction(self.c.Tr.Actions.ResolveConflictWithVersion)
+	for _, file := range files {
+		if err := self.c.Git().WorkingTree.ResolveConflictWithVersion(file, version); err != nil {
+			return err
+		}
+	}
+
+	return self.c.Refresh(types.RefreshOptions{
+		Mode:  types.ASYNC,
+		Scope: []types.RefreshableView{types.FILES},
+	})
+}
+
+func (self *WorkingTreeHelper) forgetRerereResolutions(files []*models.File) error {
+	self.c.LogAction(self.c.Tr.Actions.ForgetRerereResolution)
+	for _, file := range files {
+		if err := self.c.Git().Rerere.Forget(file.Name); err != nil {
+			return err
+		}
+	}
+
+	return self.c.Refresh(types.RefreshOptions{
+		Mode:  types.ASYNC,
+		Scope: []types.RefreshableView{types.FILES},
+	})
+}
+
 func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage string) error {
 	return self.WithEnsureCommittableFiles(func() error {
 		self.commitsHelper.OpenCommitMessagePanel(
@@ -137,6 +137,15 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 				"editKey": keybindings.Label(editCommitKey),
 			}),
 		},
+		{
+			Key:     opts.GetKey(opts.Config.Commits.InsertExecTodo),
+			Handler: self.withItemsRange(self.insertExecTodo),
+			GetDisabledReason: self.require(
+				self.itemRangeSelected(self.canInsertExecTodo),
+			),
+			Description: self.c.Tr.InsertExecTodo,
+			Tooltip:     self.c.Tr.InsertExecTodoTooltip,
+		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.PickCommit),
 			Handler: self.withItems(self.pick),
@@ -261,6 +270,14 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Description:       self.c.Tr.TagCommit,
 			Tooltip:           self.c.Tr.TagCommitTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.CreateReleaseTag),
+			Handler:           self.withItem(self.createReleaseTag),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CreateReleaseTag,
+			Tooltip:           self.c.Tr.CreateReleaseTagTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Commits.OpenLogMenu),
 			Handler:     self.handleOpenLogMenu,
@@ -290,6 +307,8 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() {
 			} else if commit.Action == todo.Exec {
 				task = types.NewRenderStringTask(
 					self.c.Tr.ExecCommandHere + "\n\n" + commit.Name)
+			} else if isNonCommitTodo(commit) {
+				task = types.NewRenderStringTask(self.nonCommitTodoDescription(commit))
 			} else {
 				refRange := self.context().GetSelectedRefRangeForDiffFiles()
 				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange)
@@ -332,7 +351,9 @@ func (self *LocalCommitsController) squashDown(selectedCommits []*models.Commit,
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.SquashingStatus, func(gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.SquashCommitDown)
-				return self.interactiveRebase(todo.Squash, startIdx, endIdx)
+				return self.c.Helpers().OperationHistory.Track(self.c.Tr.Actions.SquashCommitDown, func() error {
+					return self.interactiveRebase(todo.Squash, startIdx, endIdx)
+				})
 			})
 		},
 	})
@@ -351,7 +372,9 @@ func (self *LocalCommitsController) fixup(selectedCommits []*models.Commit, star
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.FixingStatus, func(gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.FixupCommit)
-				return self.interactiveRebase(todo.Fixup, startIdx, endIdx)
+				return self.c.Helpers().OperationHistory.Track(self.c.Tr.Actions.FixupCommit, func() error {
+					return self.interactiveRebase(todo.Fixup, startIdx, endIdx)
+				})
 			})
 		},
 	})
@@ -462,36 +485,42 @@ func (self *LocalCommitsController) rewordEditor(commit *models.Commit) error {
 
 func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
 	if self.isRebasing() {
-		groupedTodos := lo.GroupBy(selectedCommits, func(c *models.Commit) bool {
-			return c.Action == todo.UpdateRef
-		})
-		updateRefTodos := groupedTodos[true]
-		nonUpdateRefTodos := groupedTodos[false]
+		groupedTodos := lo.GroupBy(selectedCommits, isNonCommitTodo)
+		nonCommitTodos := groupedTodos[true]
+		commitTodos := groupedTodos[false]
 
-		if len(updateRefTodos) > 0 {
-			self.c.Confirm(types.ConfirmOpts{
-				Title:  self.c.Tr.DropCommitTitle,
-				Prompt: self.c.Tr.DropUpdateRefPrompt,
-				HandleConfirm: func() error {
-					selectedIdx, rangeStartIdx, rangeSelectMode := self.context().GetSelectionRangeAndMode()
+		if len(nonCommitTodos) > 0 {
+			deleteNonCommitTodos := func() error {
+				selectedIdx, rangeStartIdx, rangeSelectMode := self.context().GetSelectionRangeAndMode()
 
-					if err := self.c.Git().Rebase.DeleteUpdateRefTodos(updateRefTodos); err != nil {
-						return err
-					}
+				if err := self.c.Git().Rebase.DeleteNonCommitTodos(nonCommitTodos); err != nil {
+					return err
+				}
 
-					if selectedIdx > rangeStartIdx {
-						selectedIdx = max(selectedIdx-len(updateRefTodos), rangeStartIdx)
-					} else {
-						rangeStartIdx = max(rangeStartIdx-len(updateRefTodos), selectedIdx)
-					}
+				if selectedIdx > rangeStartIdx {
+					selectedIdx = max(selectedIdx-len(nonCommitTodos), rangeStartIdx)
+				} else {
+					rangeStartIdx = max(rangeStartIdx-len(nonCommitTodos), selectedIdx)
+				}
 
-					self.context().SetSelectionRangeAndMode(selectedIdx, rangeStartIdx, rangeSelectMode)
+				self.context().SetSelectionRangeAndMode(selectedIdx, rangeStartIdx, rangeSelectMode)
 
-					return self.updateTodos(todo.Drop, nonUpdateRefTodos)
-				},
-			})
+				return self.updateTodos(todo.Drop, commitTodos)
+			}
+
+			// Dropping an update-ref todo means that the branch is no longer
+			// updated, which is easy to miss, so we ask for confirmation
+			if lo.SomeBy(nonCommitTodos, func(c *models.Commit) bool { return c.Action == todo.UpdateRef }) {
+				self.c.Confirm(types.ConfirmOpts{
+					Title:         self.c.Tr.DropCommitTitle,
+					Prompt:        self.c.Tr.DropUpdateRefPrompt,
+					HandleConfirm: deleteNonCommitTodos,
+				})
+
+				return nil
+			}
 
-			return nil
+			return deleteNonCommitTodos()
 		}
 
 		return self.updateTodos(todo.Drop, selectedCommits)
@@ -505,10 +534,12 @@ func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, start
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.DroppingStatus, func(gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.DropCommit)
-				if isMerge {
-					return self.dropMergeCommit(startIdx)
-				}
-				return self.interactiveRebase(todo.Drop, startIdx, endIdx)
+				return self.c.Helpers().OperationHistory.Track(self.c.Tr.Actions.DropCommit, func() error {
+					if isMerge {
+						return self.dropMergeCommit(startIdx)
+					}
+					return self.interactiveRebase(todo.Drop, startIdx, endIdx)
+				})
 			})
 		},
 	})
@@ -543,6 +574,65 @@ func (self *LocalCommitsController) edit(selectedCommits []*models.Commit, start
 	return self.startInteractiveRebaseWithEdit(selectedCommits)
 }
 
+func (self *LocalCommitsController) nonCommitTodoDescription(commit *models.Commit) string {
+	template := ""
+	switch commit.Action {
+	case todo.Label:
+		template = self.c.Tr.LabelHere
+	case todo.Reset:
+		template = self.c.Tr.ResetToLabelHere
+	case todo.Merge:
+		template = self.c.Tr.MergeLabelHere
+	}
+
+	return utils.ResolvePlaceholderString(template, map[string]string{"label": commit.Name})
+}
+
+func (self *LocalCommitsController) insertExecTodo(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.InsertExecTodoTitle,
+		HandleConfirm: func(command string) error {
+			if strings.TrimSpace(command) == "" {
+				return errors.New(self.c.Tr.ExecCommandCannotBeEmpty)
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.InsertExecTodo)
+
+			if self.isRebasing() {
+				if err := self.c.Git().Rebase.InsertExecTodos(selectedCommits, command); err != nil {
+					return err
+				}
+
+				return self.c.Refresh(types.RefreshOptions{
+					Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+				})
+			}
+
+			return self.c.WithWaitingStatusSync(self.c.Tr.RebasingStatus, func() error {
+				err := self.c.Git().Rebase.ExecAfterCommits(self.c.Model().Commits, startIdx, endIdx, command)
+				return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
+					err, types.RefreshOptions{Mode: types.SYNC})
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *LocalCommitsController) canInsertExecTodo(selectedCommits []*models.Commit, startIdx int, endIdx int) *types.DisabledReason {
+	if !self.isRebasing() {
+		return nil
+	}
+
+	for _, commit := range selectedCommits {
+		if !commit.IsTODO() || commit.Action == models.ActionConflict {
+			return &types.DisabledReason{Text: self.c.Tr.MustSelectTodoCommits}
+		}
+	}
+
+	return nil
+}
+
 func (self *LocalCommitsController) quickStartInteractiveRebase() error {
 	commitToEdit, err := self.findCommitForQuickStartInteractiveRebase()
 	if err != nil {
@@ -755,7 +845,9 @@ func (self *LocalCommitsController) amendTo(commit *models.Commit) error {
 			return self.c.Helpers().WorkingTree.WithEnsureCommittableFiles(func() error {
 				return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
 					self.c.LogAction(self.c.Tr.Actions.AmendCommit)
-					err := self.c.Git().Rebase.AmendTo(self.c.Model().Commits, self.context().GetView().SelectedLineIdx())
+					err := self.c.Helpers().OperationHistory.Track(self.c.Tr.Actions.AmendCommit, func() error {
+						return self.c.Git().Rebase.AmendTo(self.c.Model().Commits, self.context().GetView().SelectedLineIdx())
+					})
 					return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
 				})
 			})
@@ -1162,6 +1254,10 @@ func (self *LocalCommitsController) createTag(commit *models.Commit) error {
 	return self.c.Helpers().Tags.OpenCreateTagPrompt(commit.Hash, func() {})
 }
 
+func (self *LocalCommitsController) createReleaseTag(commit *models.Commit) error {
+	return self.c.Helpers().Tags.OpenCreateReleaseTagMenu(commit.Hash)
+}
+
 func (self *LocalCommitsController) openSearch() error {
 	// we usually lazyload these commits but now that we're searching we need to load them now
 	if self.context().GetLimitCommits() {
@@ -1447,8 +1543,8 @@ func (self *LocalCommitsController) midRebaseMoveCommandEnabled(selectedCommits
 		}
 
 		// All todo types that can be edited are allowed to be moved, plus
-		// update-ref todos
-		if !isChangeOfRebaseTodoAllowed(commit.Action) && commit.Action != todo.UpdateRef {
+		// update-ref, exec, label, reset, and merge todos
+		if !isChangeOfRebaseTodoAllowed(commit.Action) && !utils.IsRenderedNonCommitTodo(commit.Action) {
 			return &types.DisabledReason{Text: self.c.Tr.ChangingThisActionIsNotAllowed}
 		}
 	}
@@ -1465,11 +1561,11 @@ func (self *LocalCommitsController) canDropCommits(selectedCommits []*models.Com
 		return nil
 	}
 
-	nonUpdateRefTodos := lo.Filter(selectedCommits, func(c *models.Commit, _ int) bool {
-		return c.Action != todo.UpdateRef
+	commitTodos := lo.Reject(selectedCommits, func(c *models.Commit, _ int) bool {
+		return isNonCommitTodo(c)
 	})
 
-	for _, commit := range nonUpdateRefTodos {
+	for _, commit := range commitTodos {
 		if !commit.IsTODO() {
 			return &types.DisabledReason{Text: self.c.Tr.MustSelectTodoCommits}
 		}
@@ -1493,6 +1589,13 @@ var standardActions = []todo.TodoCommand{
 	todo.Reword,
 }
 
+// Todos that don't pick a commit (update-ref, exec, label, reset, and merges
+// that don't re-create an existing merge commit) can't be changed to a
+// different action; dropping them deletes them from the todo list.
+func isNonCommitTodo(commit *models.Commit) bool {
+	return commit.IsTODO() && commit.Hash == "" && utils.IsRenderedNonCommitTodo(commit.Action)
+}
+
 func isChangeOfRebaseTodoAllowed(oldAction todo.TodoCommand) bool {
 	// Only allow updating a standard action, meaning we disallow
 	// updating a merge commit or update ref commit (until we decide what would be sensible
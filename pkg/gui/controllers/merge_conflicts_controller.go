@@ -4,9 +4,13 @@ import (
 	"os"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/mergeconflicts"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type MergeConflictsController struct {
@@ -31,12 +35,14 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Key:             opts.GetKey(opts.Config.Universal.Select),
 			Handler:         self.withRenderAndFocus(self.HandlePickHunk),
 			Description:     self.c.Tr.PickHunk,
+			Tooltip:         self.c.Tr.PickHunkTooltip,
 			DisplayOnScreen: true,
 		},
 		{
 			Key:             opts.GetKey(opts.Config.Main.PickBothHunks),
 			Handler:         self.withRenderAndFocus(self.HandlePickAllHunks),
 			Description:     self.c.Tr.PickAllHunks,
+			Tooltip:         self.c.Tr.PickAllHunksTooltip,
 			DisplayOnScreen: true,
 		},
 		{
@@ -63,6 +69,38 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Description:     self.c.Tr.NextConflict,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:             opts.GetKey(opts.Config.Main.ToggleThreeWayView),
+			Handler:         self.withRenderAndFocus(self.ToggleThreeWayView),
+			Description:     self.c.Tr.ToggleThreeWayView,
+			Tooltip:         self.threeWayViewTooltip(opts),
+			DisplayOnScreen: true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.TogglePanel),
+			Handler:           self.withRenderAndFocus(self.NextPane),
+			GetDisabledReason: self.requireThreeWayView,
+			Description:       self.c.Tr.ThreeWayNextPane,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.New),
+			Handler:           self.withLock(self.HandleAddResultLine),
+			GetDisabledReason: self.requireThreeWayView,
+			Description:       self.c.Tr.ThreeWayAddLine,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Main.EditSelectHunk),
+			Handler:           self.withLock(self.HandleEditResultLine),
+			GetDisabledReason: self.requireSelectedResultLine,
+			Description:       self.c.Tr.ThreeWayEditLine,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Confirm),
+			Handler:           self.withRenderAndFocus(self.HandleApplyResult),
+			GetDisabledReason: self.requireThreeWayView,
+			Description:       self.c.Tr.ThreeWayApplyResult,
+			Tooltip:           self.c.Tr.ThreeWayApplyResultTooltip,
+		},
 		{
 			Key:             opts.GetKey(opts.Config.Universal.Undo),
 			Handler:         self.withRenderAndFocus(self.HandleUndo),
@@ -112,10 +150,11 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Tag:         "navigation",
 		},
 		{
-			Key:             opts.GetKey(opts.Config.Files.OpenMergeTool),
-			Handler:         self.c.Helpers().WorkingTree.OpenMergeTool,
-			Description:     self.c.Tr.OpenMergeTool,
-			Tooltip:         self.c.Tr.OpenMergeToolTooltip,
+			Key:             opts.GetKey(opts.Config.Files.OpenMergeOptions),
+			Handler:         self.openMergeConflictMenu,
+			Description:     self.c.Tr.OpenMergeOptions,
+			Tooltip:         self.c.Tr.OpenMergeOptionsTooltip,
+			OpensMenu:       true,
 			DisplayOnScreen: true,
 		},
 		{
@@ -201,6 +240,14 @@ func (self *MergeConflictsController) HandleOpenFile() error {
 	return self.c.Helpers().Files.OpenFile(self.context().GetState().GetPath())
 }
 
+func (self *MergeConflictsController) openMergeConflictMenu() error {
+	path := self.context().GetState().GetPath()
+	files := lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+		return file.Name == path
+	})
+	return self.c.Helpers().WorkingTree.CreateMergeConflictMenu(files)
+}
+
 func (self *MergeConflictsController) HandleScrollLeft() error {
 	self.context().GetViewTrait().ScrollLeft()
 
@@ -232,14 +279,22 @@ func (self *MergeConflictsController) HandleUndo() error {
 
 func (self *MergeConflictsController) PrevConflictHunk() error {
 	self.context().SetUserScrolling(false)
-	self.context().GetState().SelectPrevConflictHunk()
+	if self.context().IsThreeWay() {
+		self.context().GetState().SelectPrevPaneLine()
+	} else {
+		self.context().GetState().SelectPrevConflictHunk()
+	}
 
 	return nil
 }
 
 func (self *MergeConflictsController) NextConflictHunk() error {
 	self.context().SetUserScrolling(false)
-	self.context().GetState().SelectNextConflictHunk()
+	if self.context().IsThreeWay() {
+		self.context().GetState().SelectNextPaneLine()
+	} else {
+		self.context().GetState().SelectNextConflictHunk()
+	}
 
 	return nil
 }
@@ -259,13 +314,89 @@ func (self *MergeConflictsController) PrevConflict() error {
 }
 
 func (self *MergeConflictsController) HandlePickHunk() error {
+	if self.context().IsThreeWay() {
+		self.context().GetState().TogglePickedLine()
+		return nil
+	}
+
 	return self.pickSelection(self.context().GetState().Selection())
 }
 
 func (self *MergeConflictsController) HandlePickAllHunks() error {
+	if self.context().IsThreeWay() {
+		self.context().GetState().PickAllPaneLines()
+		return nil
+	}
+
 	return self.pickSelection(mergeconflicts.ALL)
 }
 
+func (self *MergeConflictsController) ToggleThreeWayView() error {
+	self.context().SetUserScrolling(false)
+	self.context().ToggleThreeWay()
+
+	return nil
+}
+
+func (self *MergeConflictsController) NextPane() error {
+	self.context().GetState().SelectNextPane()
+
+	return nil
+}
+
+func (self *MergeConflictsController) HandleAddResultLine() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.ThreeWayAddLine,
+		HandleConfirm: self.withRenderAfterPrompt(func(text string) {
+			self.context().GetState().InsertResultLine(text)
+		}),
+	})
+
+	return nil
+}
+
+func (self *MergeConflictsController) HandleEditResultLine() error {
+	line, _ := self.context().GetState().SelectedResultLine()
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.ThreeWayEditLine,
+		InitialContent: line,
+		HandleConfirm: self.withRenderAfterPrompt(func(text string) {
+			self.context().GetState().EditSelectedResultLine(text)
+		}),
+	})
+
+	return nil
+}
+
+func (self *MergeConflictsController) HandleApplyResult() error {
+	self.context().SetUserScrolling(false)
+
+	state := self.context().GetState()
+
+	ok, content, err := state.ContentAfterThreeWayResolve()
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return nil
+	}
+
+	self.c.LogAction("Resolve merge conflict")
+	self.c.LogCommand("Applying three-way result", false)
+	state.PushContent(content)
+	if err := os.WriteFile(state.GetPath(), []byte(content), 0o644); err != nil {
+		return err
+	}
+
+	if state.AllConflictsResolved() {
+		return self.onLastConflictResolved()
+	}
+
+	return nil
+}
+
 func (self *MergeConflictsController) pickSelection(selection mergeconflicts.Selection) error {
 	ok, err := self.resolveConflict(selection)
 	if err != nil {
@@ -320,6 +451,37 @@ func (self *MergeConflictsController) onLastConflictResolved() error {
 	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
 }
 
+func (self *MergeConflictsController) threeWayViewTooltip(opts types.KeybindingsOpts) string {
+	return utils.ResolvePlaceholderString(self.c.Tr.ToggleThreeWayViewTooltip, map[string]string{
+		"selectKey":      keybindings.Label(opts.Config.Universal.Select),
+		"pickAllKey":     keybindings.Label(opts.Config.Main.PickBothHunks),
+		"togglePanelKey": keybindings.Label(opts.Config.Universal.TogglePanel),
+		"newKey":         keybindings.Label(opts.Config.Universal.New),
+		"editKey":        keybindings.Label(opts.Config.Main.EditSelectHunk),
+		"confirmKey":     keybindings.Label(opts.Config.Universal.Confirm),
+	})
+}
+
+func (self *MergeConflictsController) requireThreeWayView() *types.DisabledReason {
+	if !self.context().IsThreeWay() {
+		return &types.DisabledReason{Text: self.c.Tr.OnlyAvailableInThreeWayView}
+	}
+
+	return nil
+}
+
+func (self *MergeConflictsController) requireSelectedResultLine() *types.DisabledReason {
+	if reason := self.requireThreeWayView(); reason != nil {
+		return reason
+	}
+
+	if _, ok := self.context().GetState().SelectedResultLine(); !ok {
+		return &types.DisabledReason{Text: self.c.Tr.NoResultLineSelected}
+	}
+
+	return nil
+}
+
 func (self *MergeConflictsController) withRenderAndFocus(f func() error) func() error {
 	return self.withLock(func() error {
 		if err := f(); err != nil {
@@ -331,6 +493,21 @@ func (self *MergeConflictsController) withRenderAndFocus(f func() error) func()
 	})
 }
 
+// Closing the prompt has already refocused the merge conflicts view and queued
+// up a render of its previous content, so we need to render through the same
+// route for the new content to end up in the view.
+func (self *MergeConflictsController) withRenderAfterPrompt(f func(string)) func(string) error {
+	return func(text string) error {
+		return self.withLock(func() error {
+			f(text)
+
+			self.c.Helpers().MergeConflicts.Render()
+			self.context().SetSelectedLineRange()
+			return nil
+		})()
+	}
+}
+
 func (self *MergeConflictsController) withLock(f func() error) func() error {
 	return func() error {
 		self.context().GetMutex().Lock()
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type OperationHistoryController struct {
+	baseController
+	*ListControllerTrait[*models.Operation]
+	c *ControllerCommon
+}
+
+var _ types.IController = &OperationHistoryController{}
+
+func NewOperationHistoryController(
+	c *ControllerCommon,
+) *OperationHistoryController {
+	return &OperationHistoryController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.Operation](
+			c,
+			c.Contexts().OperationHistory,
+			c.Contexts().OperationHistory.GetSelected,
+			c.Contexts().OperationHistory.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *OperationHistoryController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Select),
+			Handler:           self.withItem(self.c.Helpers().OperationHistory.RollBack),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canRollBack)),
+			Description:       self.c.Tr.RollBackOperation,
+			Tooltip:           self.c.Tr.RollBackOperationTooltip,
+			DisplayOnScreen:   true,
+		},
+	}
+}
+
+func (self *OperationHistoryController) Context() types.Context {
+	return self.context()
+}
+
+func (self *OperationHistoryController) context() *context.OperationHistoryContext {
+	return self.c.Contexts().OperationHistory
+}
+
+func (self *OperationHistoryController) GetOnRenderToMain() func() {
+	return func() {
+		operation := self.context().GetSelected()
+		if operation == nil {
+			return
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.OperationMainTitle,
+				Task:  types.NewRenderStringTask(strings.Join(operation.Changes, "\n")),
+			},
+		})
+	}
+}
+
+func (self *OperationHistoryController) canRollBack(operation *models.Operation) *types.DisabledReason {
+	return self.c.Helpers().OperationHistory.RollBackDisabledReason(operation, self.context().GetCurrentRefs())
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type RangeDiffController struct {
+	baseController
+	*ListControllerTrait[*models.RangeDiffPair]
+	c *ControllerCommon
+}
+
+var _ types.IController = &RangeDiffController{}
+
+func NewRangeDiffController(
+	c *ControllerCommon,
+) *RangeDiffController {
+	return &RangeDiffController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*models.RangeDiffPair](
+			c,
+			c.Contexts().RangeDiff,
+			c.Contexts().RangeDiff.GetSelected,
+			c.Contexts().RangeDiff.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *RangeDiffController) GetOnRenderToMain() func() {
+	return func() {
+		pair := self.context().GetSelected()
+		if pair == nil {
+			return
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.RangeDiffMainTitle,
+				Task:  self.mainViewTask(pair),
+			},
+		})
+	}
+}
+
+// For pairs whose patches differ we show the interdiff; for all others we show
+// the commit itself, since there's nothing to compare it with.
+func (self *RangeDiffController) mainViewTask(pair *models.RangeDiffPair) types.UpdateTask {
+	if pair.Status == models.RangeDiffChanged {
+		return types.NewRenderStringTask(pair.Interdiff)
+	}
+
+	hash := pair.NewHash
+	note := self.c.Tr.RangeDiffPatchesAreIdentical
+	switch pair.Status {
+	case models.RangeDiffRemoved:
+		hash = pair.OldHash
+		note = self.c.Tr.RangeDiffCommitOnlyInOldRange
+	case models.RangeDiffAdded:
+		note = self.c.Tr.RangeDiffCommitOnlyInNewRange
+	}
+
+	cmdObj := self.c.Git().Commit.ShowCmdObj(hash, self.c.Modes().Filtering.GetPath())
+	task := types.NewRunPtyTask(cmdObj.GetCmd())
+	task.Prefix = style.FgYellow.Sprintf("%s\n\n", note)
+	return task
+}
+
+func (self *RangeDiffController) context() *context.RangeDiffContext {
+	return self.c.Contexts().RangeDiff
+}
@@ -94,6 +94,13 @@ func (self *RemoteBranchesController) GetKeybindings(opts types.KeybindingsOpts)
 			Tooltip:           self.c.Tr.ResetTooltip,
 			OpensMenu:         true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewReflog),
+			Handler:           self.withItem(self.viewReflog),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ViewBranchReflog,
+			Tooltip:           self.c.Tr.ViewBranchReflogTooltip,
+		},
 		{
 			Key: opts.GetKey(opts.Config.Universal.OpenDiffTool),
 			Handler: self.withItem(func(selectedBranch *models.RemoteBranch) error {
@@ -161,6 +168,10 @@ func (self *RemoteBranchesController) createResetMenu(selectedBranch *models.Rem
 	return self.c.Helpers().Refs.CreateGitResetMenu(selectedBranch.FullName())
 }
 
+func (self *RemoteBranchesController) viewReflog(selectedBranch *models.RemoteBranch) error {
+	return self.c.Helpers().Refs.ViewBranchReflog(selectedBranch)
+}
+
 func (self *RemoteBranchesController) setAsUpstream(selectedBranch *models.RemoteBranch) error {
 	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 
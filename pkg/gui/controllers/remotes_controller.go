@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -10,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type RemotesController struct {
@@ -78,6 +81,14 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Tooltip:           self.c.Tr.FetchRemoteTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.CheckoutPullRequest),
+			Handler:           self.withItem(self.checkoutPullRequest),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CheckoutPullRequest,
+			Tooltip:           self.c.Tr.CheckoutPullRequestTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -256,3 +267,113 @@ func (self *RemotesController) fetch(remote *models.Remote) error {
 		})
 	})
 }
+
+func (self *RemotesController) checkoutPullRequest(remote *models.Remote) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingPullRequestsStatus, func(gocui.Task) error {
+		// Not being able to list the pull requests (e.g. because the service's
+		// API isn't supported) doesn't stop us from checking one out by number
+		var pullRequests []*models.PullRequest
+		client, err := self.c.Helpers().Host.GetAPIClientForRemote(remote.Name)
+		if err == nil {
+			pullRequests, err = client.GetOpenPullRequests()
+		}
+		if err != nil {
+			self.c.Log.Warnf("Could not load pull requests of remote %s: %v", remote.Name, err)
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.openCheckoutPullRequestMenu(remote, pullRequests)
+		})
+		return nil
+	})
+}
+
+func (self *RemotesController) openCheckoutPullRequestMenu(remote *models.Remote, pullRequests []*models.PullRequest) error {
+	menuItems := []*types.MenuItem{
+		{
+			LabelColumns: []string{self.c.Tr.EnterPullRequestNumber},
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title: self.c.Tr.PullRequestNumberPrompt,
+					HandleConfirm: func(input string) error {
+						number, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(input), "#"))
+						if err != nil || number <= 0 {
+							return errors.New(utils.ResolvePlaceholderString(
+								self.c.Tr.InvalidPullRequestNumber,
+								map[string]string{"number": input},
+							))
+						}
+						return self.promptForPullRequestBranchName(remote, number, "")
+					},
+				})
+				return nil
+			},
+		},
+	}
+
+	for _, pr := range pullRequests {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				style.FgMagenta.Sprintf("#%d", pr.Number),
+				pr.Title,
+				style.FgYellow.Sprint(pr.HeadBranch),
+			},
+			OnPress: func() error {
+				return self.promptForPullRequestBranchName(remote, pr.Number, pr.HeadBranch)
+			},
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CheckoutPullRequest,
+		Items: menuItems,
+	})
+}
+
+func (self *RemotesController) promptForPullRequestBranchName(remote *models.Remote, number int, headBranch string) error {
+	// Name the branch like the pull request's branch, unless that would clash
+	// with one of ours (e.g. a pull request from a fork's main branch)
+	branchName := headBranch
+	branchExists := lo.ContainsBy(self.c.Model().Branches, func(branch *models.Branch) bool {
+		return branch.Name == branchName
+	})
+	if branchName == "" || branchExists {
+		branchName = fmt.Sprintf("pr/%d", number)
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.PullRequestBranchNamePrompt,
+			map[string]string{"number": strconv.Itoa(number)},
+		),
+		InitialContent: branchName,
+		HandleConfirm: func(branchName string) error {
+			return self.fetchAndCheckoutPullRequest(remote, number, branchName)
+		},
+	})
+
+	return nil
+}
+
+func (self *RemotesController) fetchAndCheckoutPullRequest(remote *models.Remote, number int, branchName string) error {
+	headRef, err := self.c.Helpers().Host.GetPullRequestHeadRef(remote.Name, number)
+	if err != nil {
+		return err
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingPullRequestStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CheckoutPullRequest)
+		if err := self.c.Git().Sync.FetchPullRequest(task, remote.Name, headRef, branchName); err != nil {
+			return err
+		}
+
+		if err := self.c.Git().Branch.SetUpstreamRef(branchName, remote.Name, headRef); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.c.Helpers().Refs.CheckoutRef(branchName, types.CheckoutRefOptions{})
+		})
+		return nil
+	})
+}
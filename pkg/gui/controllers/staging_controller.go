@@ -55,6 +55,12 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Tooltip:         self.c.Tr.DiscardSelectionTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.StashAllChanges),
+			Handler:     self.StashSelection,
+			Description: self.c.Tr.StashSelection,
+			Tooltip:     self.c.Tr.StashSelectionTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.OpenFile),
 			Handler:     self.OpenFile,
@@ -203,6 +209,14 @@ func (self *StagingController) DiscardSelection() error {
 
 	reset := func() error { return self.applySelectionAndRefresh(true) }
 
+	if !self.staged {
+		// Discarding unstaged changes loses them, so make it undoable
+		discard := reset
+		reset = func() error {
+			return self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.DiscardSelectedLines, []string{self.FilePath()}, discard)
+		}
+	}
+
 	if !self.staged && !self.c.UserConfig().Gui.SkipDiscardChangeWarning {
 		self.c.Confirm(types.ConfirmOpts{
 			Title:         self.c.Tr.DiscardChangeTitle,
@@ -216,6 +230,88 @@ func (self *StagingController) DiscardSelection() error {
 	return reset()
 }
 
+func (self *StagingController) StashSelection() error {
+	if self.c.AppState.DiffContextSize == 0 {
+		return fmt.Errorf(self.c.Tr.Actions.NotEnoughContextToStash,
+			keybindings.Label(self.c.UserConfig().Keybinding.Universal.IncreaseContextInDiffView))
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.StashChanges,
+		HandleConfirm: func(stashComment string) error {
+			if err := self.stashSelection(stashComment); err != nil {
+				return err
+			}
+
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STASH, types.FILES, types.STAGING}})
+		},
+	})
+
+	return nil
+}
+
+func (self *StagingController) stashSelection(message string) error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	path := self.FilePath()
+	if path == "" {
+		return nil
+	}
+
+	firstLineIdx, lastLineIdx := state.SelectedPatchRange()
+	parsedPatch := patch.Parse(state.GetDiff())
+	transformOpts := patch.TransformOpts{
+		IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+		FileNameOverride:    path,
+	}
+	patchToStash := parsedPatch.Transform(transformOpts).FormatPlain()
+	if patchToStash == "" {
+		return nil
+	}
+
+	// Once the changes are stashed we remove them from the index (if they
+	// were staged) and from the working tree. Check that this will work before
+	// creating the stash entry, so that we don't end up with the changes both
+	// stashed and still there.
+	transformOpts.Reverse = true
+	patchToRemove := parsedPatch.Transform(transformOpts).FormatPlain()
+	removeOpts := []git_commands.ApplyPatchOpts{{Reverse: true}}
+	if self.staged {
+		removeOpts = []git_commands.ApplyPatchOpts{{Reverse: true, Cached: true}, {Reverse: true}}
+	}
+	for _, opts := range removeOpts {
+		opts.Check = true
+		if err := self.c.Git().Patch.ApplyPatch(patchToRemove, opts); err != nil {
+			return err
+		}
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.StashSelection)
+	if err := self.c.Git().Patch.StashPatch(patchToStash, message, self.staged); err != nil {
+		return err
+	}
+
+	for _, opts := range removeOpts {
+		if err := self.c.Git().Patch.ApplyPatch(patchToRemove, opts); err != nil {
+			// The changes are still (at least partly) there, so the stash entry
+			// would be a duplicate of them
+			if dropErr := self.c.Git().Stash.DropNewest(); dropErr != nil {
+				self.c.Log.Error(dropErr)
+			}
+			return err
+		}
+	}
+
+	if state.SelectingRange() {
+		firstLine, _ := state.SelectedViewRange()
+		state.SelectLine(firstLine)
+	}
+
+	return nil
+}
+
 func (self *StagingController) applySelectionAndRefresh(reverse bool) error {
 	if err := self.applySelection(reverse); err != nil {
 		return err
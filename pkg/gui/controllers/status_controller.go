@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -64,6 +66,13 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     func() error { self.showAllBranchLogs(); return nil },
 			Description: self.c.Tr.AllBranchesLogGraph,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.SparseCheckoutOptions),
+			Handler:     self.createSparseCheckoutMenu,
+			Description: self.c.Tr.ViewSparseCheckoutOptions,
+			Tooltip:     self.c.Tr.ViewSparseCheckoutOptionsTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -228,3 +237,174 @@ func (self *StatusController) showDashboard() {
 func (self *StatusController) handleCheckForUpdate() error {
 	return self.c.Helpers().Update.CheckForUpdateInForeground()
 }
+
+func (self *StatusController) createSparseCheckoutMenu() error {
+	sparseCheckout := self.c.Git().SparseCheckout.Get()
+	if sparseCheckout == nil {
+		return self.c.Menu(types.CreateMenuOptions{
+			Title: self.c.Tr.SparseCheckoutMenuTitle,
+			Items: []*types.MenuItem{
+				{
+					Label:   self.c.Tr.EnableSparseCheckout,
+					OnPress: self.enableSparseCheckout,
+					Key:     'e',
+				},
+			},
+		})
+	}
+
+	var notConeModeDisabledReason *types.DisabledReason
+	if !sparseCheckout.IsConeMode {
+		notConeModeDisabledReason = &types.DisabledReason{Text: self.c.Tr.SparseCheckoutNotInConeMode}
+	}
+
+	patternsSection := &types.MenuSection{Title: self.c.Tr.SparseCheckoutDirectories}
+	patternItems := lo.Map(sparseCheckout.Patterns, func(pattern string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label:          pattern,
+			Tooltip:        self.c.Tr.RemoveSparseCheckoutDirectoryTooltip,
+			DisabledReason: notConeModeDisabledReason,
+			OnPress: func() error {
+				return self.removeSparseCheckoutDirectory(sparseCheckout, pattern)
+			},
+			Section: patternsSection,
+		}
+	})
+
+	actionsSection := &types.MenuSection{Title: self.c.Tr.SparseCheckoutActions}
+	actionItems := []*types.MenuItem{
+		{
+			Label:          self.c.Tr.AddSparseCheckoutDirectory,
+			DisabledReason: notConeModeDisabledReason,
+			OnPress:        self.addSparseCheckoutDirectory,
+			Key:            'a',
+			Section:        actionsSection,
+		},
+		{
+			Label:   self.c.Tr.ReapplySparseCheckout,
+			Tooltip: self.c.Tr.ReapplySparseCheckoutTooltip,
+			OnPress: func() error {
+				return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.ReapplySparseCheckout)
+					if err := self.c.Git().SparseCheckout.Reapply(); err != nil {
+						return err
+					}
+					return self.refreshAfterSparseCheckoutChange()
+				})
+			},
+			Key:     'r',
+			Section: actionsSection,
+		},
+		{
+			Label:   self.c.Tr.DisableSparseCheckout,
+			OnPress: self.disableSparseCheckout,
+			Key:     'd',
+			Section: actionsSection,
+		},
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SparseCheckoutMenuTitle,
+		Items: append(patternItems, actionItems...),
+	})
+}
+
+// Directories outside the cone don't exist in the working tree, so we can't
+// use the usual file path suggestions here
+func (self *StatusController) getSparseCheckoutDirectorySuggestionsFunc() func(string) []*types.Suggestion {
+	dirs, err := self.c.Git().SparseCheckout.AllDirectories()
+	if err != nil {
+		self.c.Log.Error(err)
+	}
+
+	return helpers.FilterFunc(dirs, self.c.UserConfig().Gui.UseFuzzySearch())
+}
+
+func (self *StatusController) enableSparseCheckout() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.EnableSparseCheckoutTitle,
+		FindSuggestionsFunc: self.getSparseCheckoutDirectorySuggestionsFunc(),
+		HandleConfirm: func(dir string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.EnableSparseCheckout)
+				if err := self.c.Git().SparseCheckout.Enable(sparseCheckoutDirs(dir)); err != nil {
+					return err
+				}
+				return self.refreshAfterSparseCheckoutChange()
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *StatusController) addSparseCheckoutDirectory() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.AddSparseCheckoutDirectoryTitle,
+		FindSuggestionsFunc: self.getSparseCheckoutDirectorySuggestionsFunc(),
+		HandleConfirm: func(dir string) error {
+			dirs := sparseCheckoutDirs(dir)
+			if len(dirs) == 0 {
+				return nil
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.AddSparseCheckoutDirectory)
+				if err := self.c.Git().SparseCheckout.Add(dirs); err != nil {
+					return err
+				}
+				return self.refreshAfterSparseCheckoutChange()
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *StatusController) removeSparseCheckoutDirectory(sparseCheckout *models.SparseCheckout, dir string) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RemoveSparseCheckoutDirectory,
+		Prompt: fmt.Sprintf(self.c.Tr.RemoveSparseCheckoutDirectoryPrompt, dir),
+		HandleConfirm: func() error {
+			remaining := lo.Without(sparseCheckout.Patterns, dir)
+			return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.RemoveSparseCheckoutDirectory)
+				if err := self.c.Git().SparseCheckout.Set(remaining); err != nil {
+					return err
+				}
+				return self.refreshAfterSparseCheckoutChange()
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *StatusController) disableSparseCheckout() error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.DisableSparseCheckout,
+		Prompt: self.c.Tr.DisableSparseCheckoutPrompt,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.UpdatingSparseCheckoutStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DisableSparseCheckout)
+				if err := self.c.Git().SparseCheckout.Disable(); err != nil {
+					return err
+				}
+				return self.refreshAfterSparseCheckoutChange()
+			})
+		},
+	})
+
+	return nil
+}
+
+func (self *StatusController) refreshAfterSparseCheckoutChange() error {
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES, types.SPARSE_CHECKOUT}})
+}
+
+// Allows entering several directories at once, separated by spaces
+func sparseCheckoutDirs(input string) []string {
+	return lo.Map(strings.Fields(input), func(dir string, _ int) string {
+		return strings.Trim(dir, "/")
+	})
+}
@@ -1,11 +1,18 @@
 package controllers
 
 import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type TagsController struct {
@@ -48,23 +55,37 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Tooltip:         self.c.Tr.NewTagTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateReleaseTag),
+			Handler:     self.createReleaseTag,
+			Description: self.c.Tr.CreateReleaseTag,
+			Tooltip:     self.c.Tr.CreateReleaseTagTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
-			Handler:           self.withItem(self.delete),
+			Handler:           self.withItems(self.delete),
 			Description:       self.c.Tr.Delete,
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
 			Tooltip:           self.c.Tr.TagDeleteTooltip,
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.PushTag),
-			Handler:           self.withItem(self.push),
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			Handler:           self.withItems(self.push),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
 			Description:       self.c.Tr.PushTag,
 			Tooltip:           self.c.Tr.PushTagTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.VerifyTag),
+			Handler:           self.withItem(self.verify),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isAnnotated)),
+			Description:       self.c.Tr.VerifyTag,
+			Tooltip:           self.c.Tr.VerifyTagTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.ViewResetOptions),
 			Handler:           self.withItem(self.createResetMenu),
@@ -87,6 +108,12 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 	return bindings
 }
 
+func (self *TagsController) GetOnFocus() func(types.OnFocusOpts) {
+	return func(types.OnFocusOpts) {
+		self.c.Helpers().Tags.LoadRemoteTagsIfNotLoaded()
+	}
+}
+
 func (self *TagsController) GetOnRenderToMain() func() {
 	return func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
@@ -96,7 +123,7 @@ func (self *TagsController) GetOnRenderToMain() func() {
 				task = types.NewRenderStringTask("No tags")
 			} else {
 				cmdObj := self.c.Git().Branch.GetGraphCmdObj(tag.FullRefName())
-				task = types.NewRunCommandTask(cmdObj.GetCmd())
+				task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), self.tagDetails(tag)+"\n")
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -110,6 +137,37 @@ func (self *TagsController) GetOnRenderToMain() func() {
 	}
 }
 
+// Shows what we know about the tag above its graph
+func (self *TagsController) tagDetails(tag *models.Tag) string {
+	var builder strings.Builder
+	w := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	date := utils.UnixToDateSmart(time.Now(), tag.Date.Unix(), self.c.UserConfig().Gui.TimeFormat, self.c.UserConfig().Gui.ShortTimeFormat)
+	if tag.IsAnnotated {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.TagTagger, style.FgGreen.Sprint(tag.Tagger))
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.TagDate, style.FgBlue.Sprint(date))
+		signature := lo.Ternary(tag.IsSigned, style.FgGreen.Sprint(self.c.Tr.TagSigned), style.FgDefault.Sprint(self.c.Tr.TagNotSigned))
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.TagSignature, signature)
+	} else {
+		_, _ = fmt.Fprintf(w, "%s\n", style.FgDefault.Sprint(self.c.Tr.TagLightweight))
+	}
+	if remoteTagNames := self.c.Model().RemoteTagNames; remoteTagNames != nil {
+		remote := map[string]string{"remote": self.c.Model().RemoteForTags}
+		onRemote := lo.Ternary(remoteTagNames[tag.Name],
+			style.FgGreen.Sprint(utils.ResolvePlaceholderString(self.c.Tr.TagOnRemote, remote)),
+			style.FgYellow.Sprint(utils.ResolvePlaceholderString(self.c.Tr.TagNotOnRemote, remote)),
+		)
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.TagRemote, onRemote)
+	}
+	_ = w.Flush()
+
+	// For lightweight tags, the message is the commit's, which the graph shows
+	if tag.IsAnnotated {
+		builder.WriteString("\n" + tag.FullMessage() + "\n")
+	}
+
+	return builder.String()
+}
+
 func (self *TagsController) checkout(tag *models.Tag) error {
 	self.c.LogAction(self.c.Tr.Actions.CheckoutTag)
 	if err := self.c.Helpers().Refs.CheckoutRef(tag.FullRefName(), types.CheckoutRefOptions{}); err != nil {
@@ -119,87 +177,74 @@ func (self *TagsController) checkout(tag *models.Tag) error {
 	return nil
 }
 
-func (self *TagsController) localDelete(tag *models.Tag) error {
+func (self *TagsController) localDelete(tags []*models.Tag) error {
 	return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
-		err := self.c.Git().Tag.LocalDelete(tag.Name)
+		err := self.c.Git().Tag.LocalDelete(tagNames(tags))
+		if err == nil {
+			self.collapseSelection()
+		}
 		_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
 		return err
 	})
 }
 
-func (self *TagsController) remoteDelete(tag *models.Tag) error {
-	title := utils.ResolvePlaceholderString(
-		self.c.Tr.SelectRemoteTagUpstream,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
-
-	self.c.Prompt(types.PromptOpts{
-		Title:               title,
-		InitialContent:      "origin",
-		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
-		HandleConfirm: func(upstream string) error {
-			confirmTitle := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteTagTitle,
-				map[string]string{
-					"tagName": tag.Name,
-				},
-			)
-			confirmPrompt := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteRemoteTagPrompt,
-				map[string]string{
-					"tagName":  tag.Name,
-					"upstream": upstream,
-				},
-			)
+func (self *TagsController) remoteDelete(tags []*models.Tag) error {
+	return self.promptForRemoteAndConfirmDelete(tags, self.c.Tr.DeleteRemoteTagPrompt, self.c.Tr.DeleteRemoteTagsPrompt,
+		func(task gocui.Task, upstream string) error {
+			self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
+			if err := self.c.Git().Remote.DeleteRemoteTags(task, upstream, tagNames(tags)); err != nil {
+				return err
+			}
+			self.c.Toast(lo.Ternary(len(tags) > 1, self.c.Tr.RemoteTagsDeletedMessage, self.c.Tr.RemoteTagDeletedMessage))
+			return nil
+		})
+}
 
-			self.c.Confirm(types.ConfirmOpts{
-				Title:  confirmTitle,
-				Prompt: confirmPrompt,
-				HandleConfirm: func() error {
-					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
-						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
-						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
-							return err
-						}
-						self.c.Toast(self.c.Tr.RemoteTagDeletedMessage)
-						return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
-					})
-				},
-			})
+func (self *TagsController) localAndRemoteDelete(tags []*models.Tag) error {
+	return self.promptForRemoteAndConfirmDelete(tags, self.c.Tr.DeleteLocalAndRemoteTagPrompt, self.c.Tr.DeleteLocalAndRemoteTagsPrompt,
+		func(task gocui.Task, upstream string) error {
+			self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
+			if err := self.c.Git().Remote.DeleteRemoteTags(task, upstream, tagNames(tags)); err != nil {
+				return err
+			}
 
+			self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
+			if err := self.c.Git().Tag.LocalDelete(tagNames(tags)); err != nil {
+				return err
+			}
+			self.collapseSelection()
 			return nil
-		},
-	})
-
-	return nil
+		})
 }
 
-func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
-	title := utils.ResolvePlaceholderString(
-		self.c.Tr.SelectRemoteTagUpstream,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
+// Asks which remote to delete the tags from, and whether the user is sure
+func (self *TagsController) promptForRemoteAndConfirmDelete(
+	tags []*models.Tag,
+	singleTagPrompt string,
+	multipleTagsPrompt string,
+	doDelete func(task gocui.Task, upstream string) error,
+) error {
+	title := self.c.Tr.SelectRemoteTagsUpstream
+	if len(tags) == 1 {
+		title = utils.ResolvePlaceholderString(
+			self.c.Tr.SelectRemoteTagUpstream,
+			map[string]string{
+				"tagName": tags[0].Name,
+			},
+		)
+	}
 
 	self.c.Prompt(types.PromptOpts{
 		Title:               title,
 		InitialContent:      "origin",
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
 		HandleConfirm: func(upstream string) error {
-			confirmTitle := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteTagTitle,
-				map[string]string{
-					"tagName": tag.Name,
-				},
-			)
+			confirmTitle := self.deleteTitle(tags)
 			confirmPrompt := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteLocalAndRemoteTagPrompt,
+				lo.Ternary(len(tags) > 1, multipleTagsPrompt, singleTagPrompt),
 				map[string]string{
-					"tagName":  tag.Name,
+					"tagName":  tags[0].Name,
 					"upstream": upstream,
 				},
 			)
@@ -208,16 +253,11 @@ func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
 				Title:  confirmTitle,
 				Prompt: confirmPrompt,
 				HandleConfirm: func() error {
-					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
-						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
-						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
-							return err
-						}
-
-						self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
-						if err := self.c.Git().Tag.LocalDelete(tag.Name); err != nil {
+					return self.withStatus(tags, types.ItemOperationDeleting, self.c.Tr.DeletingStatus, func(task gocui.Task) error {
+						if err := doDelete(task, upstream); err != nil {
 							return err
 						}
+						self.c.Helpers().Tags.LoadRemoteTags(upstream)
 						return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
 					})
 				},
@@ -230,62 +270,74 @@ func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
 	return nil
 }
 
-func (self *TagsController) delete(tag *models.Tag) error {
-	menuTitle := utils.ResolvePlaceholderString(
-		self.c.Tr.DeleteTagTitle,
-		map[string]string{
-			"tagName": tag.Name,
-		},
-	)
-
+func (self *TagsController) delete(tags []*models.Tag) error {
 	menuItems := []*types.MenuItem{
 		{
-			Label: self.c.Tr.DeleteLocalTag,
+			Label: lo.Ternary(len(tags) > 1, self.c.Tr.DeleteLocalTags, self.c.Tr.DeleteLocalTag),
 			Key:   'c',
 			OnPress: func() error {
-				return self.localDelete(tag)
+				return self.localDelete(tags)
 			},
 		},
 		{
-			Label:     self.c.Tr.DeleteRemoteTag,
+			Label:     lo.Ternary(len(tags) > 1, self.c.Tr.DeleteRemoteTags, self.c.Tr.DeleteRemoteTag),
 			Key:       'r',
 			OpensMenu: true,
 			OnPress: func() error {
-				return self.remoteDelete(tag)
+				return self.remoteDelete(tags)
 			},
 		},
 		{
-			Label:     self.c.Tr.DeleteLocalAndRemoteTag,
+			Label:     lo.Ternary(len(tags) > 1, self.c.Tr.DeleteLocalAndRemoteTags, self.c.Tr.DeleteLocalAndRemoteTag),
 			Key:       'b',
 			OpensMenu: true,
 			OnPress: func() error {
-				return self.localAndRemoteDelete(tag)
+				return self.localAndRemoteDelete(tags)
 			},
 		},
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{
-		Title: menuTitle,
+		Title: self.deleteTitle(tags),
 		Items: menuItems,
 	})
 }
 
-func (self *TagsController) push(tag *models.Tag) error {
-	title := utils.ResolvePlaceholderString(
-		self.c.Tr.PushTagTitle,
+func (self *TagsController) deleteTitle(tags []*models.Tag) string {
+	if len(tags) > 1 {
+		return self.c.Tr.DeleteTagsTitle
+	}
+
+	return utils.ResolvePlaceholderString(
+		self.c.Tr.DeleteTagTitle,
 		map[string]string{
-			"tagName": tag.Name,
+			"tagName": tags[0].Name,
 		},
 	)
+}
+
+func (self *TagsController) push(tags []*models.Tag) error {
+	title := self.c.Tr.PushTagsTitle
+	if len(tags) == 1 {
+		title = utils.ResolvePlaceholderString(
+			self.c.Tr.PushTagTitle,
+			map[string]string{
+				"tagName": tags[0].Name,
+			},
+		)
+	}
 
 	self.c.Prompt(types.PromptOpts{
 		Title:               title,
 		InitialContent:      "origin",
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
 		HandleConfirm: func(response string) error {
-			return self.c.WithInlineStatus(tag, types.ItemOperationPushing, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+			return self.withStatus(tags, types.ItemOperationPushing, self.c.Tr.PushingTagsStatus, func(task gocui.Task) error {
 				self.c.LogAction(self.c.Tr.Actions.PushTag)
-				err := self.c.Git().Tag.Push(task, response, tag.Name)
+				err := self.c.Git().Tag.Push(task, response, tagNames(tags))
+				if err == nil {
+					self.c.Helpers().Tags.LoadRemoteTags(response)
+				}
 
 				// Render again to remove the inline status:
 				self.c.OnUIThread(func() error {
@@ -301,6 +353,57 @@ func (self *TagsController) push(tag *models.Tag) error {
 	return nil
 }
 
+// A single tag shows the operation inline; for several of them we use the
+// waiting status instead
+func (self *TagsController) withStatus(tags []*models.Tag, operation types.ItemOperation, waitingStatus string, f func(gocui.Task) error) error {
+	if len(tags) == 1 {
+		return self.c.WithInlineStatus(tags[0], operation, context.TAGS_CONTEXT_KEY, f)
+	}
+
+	return self.c.WithWaitingStatus(waitingStatus, f)
+}
+
+// After deleting a range of tags, select the one that took the place of the
+// first deleted one. Called from the worker that deleted them.
+func (self *TagsController) collapseSelection() {
+	self.c.OnUIThread(func() error {
+		selectionStart, _ := self.context().GetSelectionRange()
+		self.context().SetSelectedLineIdx(selectionStart)
+		return nil
+	})
+}
+
+func (self *TagsController) isAnnotated(tag *models.Tag) *types.DisabledReason {
+	if !tag.IsAnnotated {
+		return &types.DisabledReason{Text: self.c.Tr.CantVerifyLightweightTag}
+	}
+
+	return nil
+}
+
+func (self *TagsController) verify(tag *models.Tag) error {
+	return self.c.WithWaitingStatus(self.c.Tr.VerifyingTagStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.VerifyTag)
+		output, err := self.c.Git().Tag.Verify(tag.Name)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			self.c.Alert(
+				utils.ResolvePlaceholderString(self.c.Tr.VerifyTagTitle, map[string]string{"tagName": tag.Name}),
+				output,
+			)
+			return nil
+		})
+		return nil
+	})
+}
+
+func tagNames(tags []*models.Tag) []string {
+	return lo.Map(tags, func(tag *models.Tag, _ int) string { return tag.Name })
+}
+
 func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(tag.Name)
 }
@@ -312,6 +415,11 @@ func (self *TagsController) create() error {
 	})
 }
 
+func (self *TagsController) createReleaseTag() error {
+	// as with creating a regular tag, we tag the current commit
+	return self.c.Helpers().Tags.OpenCreateReleaseTagMenu("")
+}
+
 func (self *TagsController) context() *context.TagsContext {
 	return self.c.Contexts().Tags
 }
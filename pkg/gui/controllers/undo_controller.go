@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -19,6 +20,11 @@ import (
 // actions we can skip. E.g. if I do three things, A, B, and C, and hit undo twice,
 // the reflog will read UUCBA, and when I read the first two undos, I know to skip the following
 // two user actions, meaning we end up undoing reflog entry C. Redoing works in a similar way.
+//
+// Changes to the working tree and index don't show up in the reflog, so destructive file-level
+// actions (like discarding changes) are recorded in a separate journal instead (see JournalHelper).
+// When undoing, we compare the timestamp of the newest journal entry with the timestamp of the
+// reflog action we'd otherwise undo, and undo whichever happened last.
 
 type UndoController struct {
 	baseController
@@ -49,6 +55,8 @@ type reflogAction struct {
 	kind ReflogActionKind
 	from string
 	to   string
+	// unix timestamp of when the action finished, i.e. of its reflog entry
+	timestamp int64
 }
 
 func (self *UndoController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
@@ -78,6 +86,20 @@ func (self *UndoController) reflogUndo() error {
 	undoEnvVars := []string{"GIT_REFLOG_ACTION=[lazygit undo]"}
 	undoingStatus := self.c.Tr.UndoingStatus
 
+	if entry := self.journalEntryToUndo(); entry != nil {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.Actions.Undo,
+			Prompt: fmt.Sprintf(self.c.Tr.UndoJournalEntryPrompt, entry.Action),
+			HandleConfirm: func() error {
+				self.c.LogAction(self.c.Tr.Actions.Undo)
+				return self.c.WithWaitingStatus(undoingStatus, func(gocui.Task) error {
+					return self.c.Helpers().Journal.Undo(entry)
+				})
+			},
+		})
+		return nil
+	}
+
 	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
 		return errors.New(self.c.Tr.CantUndoWhileRebasing)
 	}
@@ -142,6 +164,20 @@ func (self *UndoController) reflogRedo() error {
 	redoEnvVars := []string{"GIT_REFLOG_ACTION=[lazygit redo]"}
 	redoingStatus := self.c.Tr.RedoingStatus
 
+	if entry := self.journalEntryToRedo(); entry != nil {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.Actions.Redo,
+			Prompt: fmt.Sprintf(self.c.Tr.RedoJournalEntryPrompt, entry.Action),
+			HandleConfirm: func() error {
+				self.c.LogAction(self.c.Tr.Actions.Redo)
+				return self.c.WithWaitingStatus(redoingStatus, func(gocui.Task) error {
+					return self.c.Helpers().Journal.Redo(entry)
+				})
+			},
+		})
+		return nil
+	}
+
 	if self.c.Git().Status.WorkingTreeState() == enums.REBASE_MODE_REBASING {
 		return errors.New(self.c.Tr.CantRedoWhileRebasing)
 	}
@@ -192,6 +228,50 @@ func (self *UndoController) reflogRedo() error {
 	})
 }
 
+// Returns the newest journal entry if it's more recent than the reflog action
+// that we would otherwise undo, or nil. Ties go to the journal entry, since
+// it's the more likely one to have been a mistake. If HEAD has moved since the
+// entry was recorded (e.g. by a merge that we can't undo from the reflog), we
+// can't restore its snapshot, so we skip it and undo from the reflog instead.
+func (self *UndoController) journalEntryToUndo() *models.JournalEntry {
+	entry := self.c.Helpers().Journal.LastEntry()
+	if entry == nil || !self.c.Helpers().Journal.HeadMatches(entry) {
+		return nil
+	}
+
+	journalEntryIsNewer := true
+	_ = self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
+		if counter != 0 {
+			return false, nil
+		}
+
+		journalEntryIsNewer = entry.Timestamp >= action.timestamp
+		return true, nil
+	})
+
+	if !journalEntryIsNewer {
+		return nil
+	}
+
+	return entry
+}
+
+// Returns the most recently undone journal entry, unless the reflog or HEAD
+// has moved on since it was undone (in which case we redo from the reflog)
+func (self *UndoController) journalEntryToRedo() *models.JournalEntry {
+	entry := self.c.Helpers().Journal.LastUndoneEntry()
+	if entry == nil || !self.c.Helpers().Journal.HeadMatches(entry) {
+		return nil
+	}
+
+	reflogCommits := self.c.Model().FilteredReflogCommits
+	if len(reflogCommits) > 0 && reflogCommits[0].ReflogTimestamp > entry.UndoneTimestamp {
+		return nil
+	}
+
+	return entry
+}
+
 // Here we're going through the reflog and maintaining a counter that represents how many
 // undos/redos/user actions we've seen. when we hit a user action we call the callback specifying
 // what the counter is up to and the nature of the action.
@@ -202,6 +282,7 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 	counter := 0
 	reflogCommits := self.c.Model().FilteredReflogCommits
 	rebaseFinishCommitHash := ""
+	var rebaseFinishTimestamp int64
 	var action *reflogAction
 	for reflogCommitIdx, reflogCommit := range reflogCommits {
 		action = nil
@@ -218,6 +299,7 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 				counter--
 			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase (-i )?\(abort\)|^rebase (-i )?\(finish\)`); ok {
 				rebaseFinishCommitHash = reflogCommit.Hash
+				rebaseFinishTimestamp = reflogCommit.ReflogTimestamp
 			} else if ok, match := utils.FindStringSubmatch(reflogCommit.Name, `^checkout: moving from ([\S]+) to ([\S]+)`); ok {
 				action = &reflogAction{kind: CHECKOUT, from: match[1], to: match[2]}
 			} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^commit|^reset: moving to|^pull`); ok {
@@ -227,11 +309,14 @@ func (self *UndoController) parseReflogForActions(onUserAction func(counter int,
 				action = &reflogAction{kind: CURRENT_REBASE, from: prevCommitHash}
 			}
 		} else if ok, _ := utils.FindStringSubmatch(reflogCommit.Name, `^rebase (-i )?\(start\)`); ok {
-			action = &reflogAction{kind: REBASE, from: prevCommitHash, to: rebaseFinishCommitHash}
+			action = &reflogAction{kind: REBASE, from: prevCommitHash, to: rebaseFinishCommitHash, timestamp: rebaseFinishTimestamp}
 			rebaseFinishCommitHash = ""
 		}
 
 		if action != nil {
+			if action.timestamp == 0 {
+				action.timestamp = reflogCommit.ReflogTimestamp
+			}
 			if action.kind != CURRENT_REBASE && action.from == action.to {
 				// if we're going from one place to the same place we'll ignore the action.
 				continue
@@ -31,7 +31,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.NukeWorkingTree)
-				if err := self.c.Git().WorkingTree.ResetAndClean(); err != nil {
+				if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.NukeWorkingTree, nil, func() error {
+					return self.c.Git().WorkingTree.ResetAndClean()
+				}); err != nil {
 					return err
 				}
 
@@ -53,7 +55,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedFileChanges)
-				if err := self.c.Git().WorkingTree.DiscardAnyUnstagedFileChanges(); err != nil {
+				if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.DiscardUnstagedFileChanges, nil, func() error {
+					return self.c.Git().WorkingTree.DiscardAnyUnstagedFileChanges()
+				}); err != nil {
 					return err
 				}
 
@@ -70,7 +74,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.RemoveUntrackedFiles)
-				if err := self.c.Git().WorkingTree.RemoveUntrackedFiles(); err != nil {
+				if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.RemoveUntrackedFiles, nil, func() error {
+					return self.c.Git().WorkingTree.RemoveUntrackedFiles()
+				}); err != nil {
 					return err
 				}
 
@@ -91,10 +97,12 @@ func (self *FilesController) createResetMenu() error {
 				if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 					return errors.New(self.c.Tr.NoTrackedStagedFilesStash)
 				}
-				if err := self.c.Git().Stash.SaveStagedChanges("[lazygit] tmp stash"); err != nil {
-					return err
-				}
-				if err := self.c.Git().Stash.DropNewest(); err != nil {
+				if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.RemoveStagedFiles, nil, func() error {
+					if err := self.c.Git().Stash.SaveStagedChanges("[lazygit] tmp stash"); err != nil {
+						return err
+					}
+					return self.c.Git().Stash.DropNewest()
+				}); err != nil {
 					return err
 				}
 
@@ -128,7 +136,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.MixedReset)
-				if err := self.c.Git().WorkingTree.ResetMixed("HEAD"); err != nil {
+				if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.MixedReset, nil, func() error {
+					return self.c.Git().WorkingTree.ResetMixed("HEAD")
+				}); err != nil {
 					return err
 				}
 
@@ -145,7 +155,9 @@ func (self *FilesController) createResetMenu() error {
 			},
 			OnPress: func() error {
 				self.c.LogAction(self.c.Tr.Actions.HardReset)
-				if err := self.c.Git().WorkingTree.ResetHard("HEAD"); err != nil {
+				if err := self.c.Helpers().Journal.WithSnapshot(self.c.Tr.Actions.HardReset, nil, func() error {
+					return self.c.Git().WorkingTree.ResetHard("HEAD")
+				}); err != nil {
 					return err
 				}
 
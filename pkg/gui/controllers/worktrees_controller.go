@@ -3,13 +3,16 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type WorktreesController struct {
@@ -97,9 +100,17 @@ func (self *WorktreesController) GetOnRenderToMain() func() {
 			_, _ = fmt.Fprintf(w, "%s:\t%s%s\n", self.c.Tr.Name, style.FgGreen.Sprint(worktree.Name), main)
 			_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Branch, style.FgYellow.Sprint(worktree.Branch))
 			_, _ = fmt.Fprintf(w, "%s:\t%s%s\n", self.c.Tr.Path, style.FgCyan.Sprint(worktree.Path), missing)
+			if !worktree.IsPathMissing {
+				self.writeStatusRows(w, worktree)
+			}
 			_ = w.Flush()
 
-			task = types.NewRenderStringTask(builder.String())
+			if worktree.IsPathMissing {
+				task = types.NewRenderStringTask(builder.String())
+			} else {
+				cmdObj := self.c.Git().Worktree.StatusCmdObj(worktree.Path)
+				task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), builder.String()+"\n")
+			}
 		}
 
 		self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -112,6 +123,49 @@ func (self *WorktreesController) GetOnRenderToMain() func() {
 	}
 }
 
+func (self *WorktreesController) writeStatusRows(w io.Writer, worktree *models.Worktree) {
+	status := worktree.Status.Load()
+	if status == nil {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeChanges, style.FgDefault.Sprint(self.c.Tr.WorktreeStatusLoading))
+		return
+	}
+
+	changes := style.FgGreen.Sprint(self.c.Tr.WorktreeClean)
+	if status.IsDirty() {
+		changes = style.FgMagenta.Sprint(utils.ResolvePlaceholderString(
+			self.c.Tr.WorktreeChangedFiles,
+			map[string]string{"count": fmt.Sprint(status.ChangedFiles)},
+		))
+		if status.ConflictedFiles > 0 {
+			changes += ", " + style.FgRed.Sprint(utils.ResolvePlaceholderString(
+				self.c.Tr.WorktreeConflictedFiles,
+				map[string]string{"count": fmt.Sprint(status.ConflictedFiles)},
+			))
+		}
+	}
+	_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeChanges, changes)
+
+	upstream := style.FgDefault.Sprint(self.c.Tr.WorktreeNoUpstream)
+	if status.HasUpstream {
+		if status.Ahead == 0 && status.Behind == 0 {
+			upstream = style.FgGreen.Sprint(self.c.Tr.WorktreeInSync)
+		} else {
+			upstream = style.FgYellow.Sprint(utils.ResolvePlaceholderString(
+				self.c.Tr.WorktreeAheadBehind,
+				map[string]string{"ahead": fmt.Sprint(status.Ahead), "behind": fmt.Sprint(status.Behind)},
+			))
+		}
+	}
+	_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeUpstream, upstream)
+
+	switch status.WorkingTreeState {
+	case enums.REBASE_MODE_REBASING:
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeState, style.FgYellow.Sprint(self.c.Tr.RebasingStatus))
+	case enums.REBASE_MODE_MERGING:
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.WorktreeState, style.FgYellow.Sprint(self.c.Tr.MergingStatus))
+	}
+}
+
 func (self *WorktreesController) add() error {
 	return self.c.Helpers().Worktree.NewWorktree()
 }
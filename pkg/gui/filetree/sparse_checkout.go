@@ -0,0 +1,43 @@
+package filetree
+
+import "strings"
+
+// Reports whether the given path lies outside of a cone-mode sparse-checkout
+// consisting of the given directories. In cone mode, git checks out all files
+// at the root of the repo, everything below each of the cone directories, and
+// the files directly inside each of their parent directories.
+func IsOutsideSparseCheckoutCone(path string, isDir bool, coneDirs []string) bool {
+	dir := path
+	if !isDir {
+		dir = parentDir(path)
+		if dir == "" {
+			return false
+		}
+	}
+
+	for _, coneDir := range coneDirs {
+		coneDir = strings.Trim(coneDir, "/")
+		if isSameOrSubPath(dir, coneDir) {
+			return false
+		}
+
+		// parents of cone directories are checked out non-recursively
+		if isSameOrSubPath(coneDir, dir) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parentDir(path string) string {
+	index := strings.LastIndex(path, "/")
+	if index == -1 {
+		return ""
+	}
+	return path[:index]
+}
+
+func isSameOrSubPath(path string, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+"/")
+}
@@ -0,0 +1,36 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOutsideSparseCheckoutCone(t *testing.T) {
+	coneDirs := []string{"pkg/app", "docs/"}
+
+	scenarios := []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{path: "README.md", isDir: false, expected: false},
+		{path: "pkg", isDir: true, expected: false},
+		{path: "pkg/main.go", isDir: false, expected: false},
+		{path: "pkg/app", isDir: true, expected: false},
+		{path: "pkg/app/sub", isDir: true, expected: false},
+		{path: "pkg/app/sub/file.go", isDir: false, expected: false},
+		{path: "docs/index.md", isDir: false, expected: false},
+		{path: "pkg/other", isDir: true, expected: true},
+		{path: "pkg/other/file.go", isDir: false, expected: true},
+		{path: "pkg/application", isDir: true, expected: true},
+		{path: "vendor", isDir: true, expected: true},
+		{path: "vendor/lib.go", isDir: false, expected: true},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.path, func(t *testing.T) {
+			assert.Equal(t, s.expected, IsOutsideSparseCheckoutCone(s.path, s.isDir, coneDirs))
+		})
+	}
+}
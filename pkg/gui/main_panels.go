@@ -17,7 +17,7 @@ func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
 		return gui.newStringTaskWithScroll(view, v.Str, v.OriginX, v.OriginY)
 
 	case *types.RunCommandTask:
-		return gui.newCmdTask(view, v.Cmd, v.Prefix)
+		return gui.newCmdTask(view, v.Cmd, v.Prefix, v.FormatOutput)
 
 	case *types.RunPtyTask:
 		gui.afterLayout(func() error {
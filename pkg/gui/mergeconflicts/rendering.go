@@ -2,10 +2,16 @@ package mergeconflicts
 
 import (
 	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/samber/lo"
 )
 
 func ColoredConflictFile(state *State) string {
@@ -32,3 +38,90 @@ func ColoredConflictFile(state *State) string {
 func shiftConflict(conflicts []*mergeConflict) (*mergeConflict, []*mergeConflict) {
 	return conflicts[0], conflicts[1:]
 }
+
+const threeWayColumnSeparator = " │ "
+
+// RenderThreeWay renders the selected conflict as three columns (ours, base and
+// theirs) with the result of the conflict resolution below them. It also
+// returns the index of the line that contains the selection.
+func RenderThreeWay(state *State, tr *i18n.TranslationSet, width int) (string, int) {
+	if state.currentConflict() == nil {
+		return state.GetContent(), 0
+	}
+
+	columnWidth := max((width-2*runewidth.StringWidth(threeWayColumnSeparator))/3, 4)
+	selectedPane := state.SelectedPane()
+	selectedRow := 0
+
+	paneTitle := func(pane Pane, title string) string {
+		if label := state.PaneLabel(pane); label != "" {
+			title = fmt.Sprintf("%s (%s)", title, label)
+		}
+		if pane == selectedPane {
+			return style.FgCyan.SetBold().Sprint(title)
+		}
+		return style.AttrBold.Sprint(title)
+	}
+
+	lines := []string{
+		utils.ResolvePlaceholderString(tr.ThreeWayConflictTitle, map[string]string{
+			"index": strconv.Itoa(state.conflictIndex + 1),
+			"count": strconv.Itoa(len(state.conflicts)),
+		}),
+	}
+
+	sidePanes := []Pane{OURS, BASE, THEIRS}
+	titles := []string{tr.ThreeWayOurs, tr.ThreeWayBase, tr.ThreeWayTheirs}
+	lines = append(lines, joinColumns(columnWidth, lo.Map(sidePanes, func(pane Pane, i int) string {
+		return paneTitle(pane, titles[i])
+	})))
+	lines = append(lines, strings.Join(lo.Times(3, func(int) string {
+		return strings.Repeat("─", columnWidth)
+	}), "─┼─"))
+
+	paneLines := lo.Map(sidePanes, func(pane Pane, _ int) []string { return state.PaneLines(pane) })
+	rowCount := max(lo.Max(lo.Map(paneLines, func(lines []string, _ int) int { return len(lines) })), 1)
+	for row := 0; row < rowCount; row++ {
+		cells := lo.Map(sidePanes, func(pane Pane, i int) string {
+			if row >= len(paneLines[i]) {
+				if row == 0 && pane == BASE {
+					return theme.OptionsFgColor.Sprint(utils.TruncateWithEllipsis(tr.ThreeWayNoBase, columnWidth))
+				}
+				return ""
+			}
+
+			marker := "  "
+			if state.IsLinePicked(pane, row) {
+				marker = style.FgGreen.Sprint("✓ ")
+			}
+			text := utils.TruncateWithEllipsis(paneLines[i][row], columnWidth-2)
+			if pane == selectedPane && row == state.SelectedPaneLine(pane) {
+				selectedRow = len(lines)
+				text = style.New().SetReverse().Sprint(text)
+			}
+			return marker + text
+		})
+		lines = append(lines, joinColumns(columnWidth, cells))
+	}
+
+	lines = append(lines, "", paneTitle(RESULT, tr.ThreeWayResult), strings.Repeat("─", width))
+	resultLines := state.ResultLines()
+	if len(resultLines) == 0 {
+		lines = append(lines, theme.OptionsFgColor.Sprint(tr.ThreeWayEmptyResult))
+	}
+	for i, line := range resultLines {
+		if selectedPane == RESULT && i == state.SelectedPaneLine(RESULT) {
+			selectedRow = len(lines)
+			line = style.New().SetReverse().Sprint(line)
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n"), selectedRow
+}
+
+func joinColumns(columnWidth int, cells []string) string {
+	return strings.Join(lo.Map(cells, func(cell string, _ int) string {
+		return utils.WithPadding(cell, columnWidth, utils.AlignLeft)
+	}), threeWayColumnSeparator)
+}
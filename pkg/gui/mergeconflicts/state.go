@@ -22,6 +22,9 @@ type State struct {
 	// this is the index of the selected conflict's available selections slice e.g. [TOP, MIDDLE, BOTTOM]
 	// We use this to know which hunk of the conflict is selected.
 	selectionIndex int
+
+	// the state of the three-way view for the selected conflict; see three_way.go
+	threeWayState *ThreeWayState
 }
 
 func NewState() *State {
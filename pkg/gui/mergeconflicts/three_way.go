@@ -0,0 +1,290 @@
+package mergeconflicts
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Pane is one of the panes of the three-way view of a conflict
+type Pane int
+
+const (
+	OURS Pane = iota
+	BASE
+	THEIRS
+	RESULT
+)
+
+// the order in which we cycle through the panes
+var allPanes = []Pane{OURS, BASE, THEIRS, RESULT}
+
+// A line of the result of a conflict in the three-way view. Lines that were
+// picked from one of the sides remember where they came from so that picking
+// them again removes them; lines that were typed in or edited by the user have
+// RESULT as their pane.
+type resultLine struct {
+	text  string
+	pane  Pane
+	index int
+}
+
+// ThreeWayState is the state of the three-way view for the selected conflict
+// of a State. It's reset whenever a different conflict is selected.
+type ThreeWayState struct {
+	// the conflict that this state belongs to
+	conflict *mergeConflict
+
+	pane Pane
+	// the selected line index within each pane
+	lineIndices map[Pane]int
+
+	result []resultLine
+}
+
+func newThreeWayState(conflict *mergeConflict) *ThreeWayState {
+	return &ThreeWayState{
+		conflict:    conflict,
+		pane:        OURS,
+		lineIndices: map[Pane]int{},
+		result:      []resultLine{},
+	}
+}
+
+func (s *State) threeWay() *ThreeWayState {
+	conflict := s.currentConflict()
+	if s.threeWayState == nil || s.threeWayState.conflict != conflict {
+		s.threeWayState = newThreeWayState(conflict)
+		s.threeWayState.pane = s.firstNonEmptyPane()
+	}
+	return s.threeWayState
+}
+
+func (s *State) firstNonEmptyPane() Pane {
+	pane, _ := lo.Find(allPanes, func(pane Pane) bool { return len(s.PaneLines(pane)) > 0 })
+	return pane
+}
+
+// PaneLines returns the lines of the given pane for the selected conflict
+func (s *State) PaneLines(pane Pane) []string {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return nil
+	}
+
+	var selection Selection
+	switch pane {
+	case OURS:
+		selection = TOP
+	case BASE:
+		if !conflict.hasAncestor() {
+			return nil
+		}
+		selection = MIDDLE
+	case THEIRS:
+		selection = BOTTOM
+	case RESULT:
+		return s.ResultLines()
+	}
+
+	start, end := selection.bounds(conflict)
+	return utils.SplitLines(s.GetContent())[start+1 : end]
+}
+
+// PaneLabel returns the label that git put on the marker line of the given
+// pane, e.g. "HEAD" for ours or the name of the merged branch for theirs
+func (s *State) PaneLabel(pane Pane) string {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return ""
+	}
+
+	var markerIdx int
+	switch pane {
+	case OURS:
+		markerIdx = conflict.start
+	case BASE:
+		if !conflict.hasAncestor() {
+			return ""
+		}
+		markerIdx = conflict.ancestor
+	case THEIRS:
+		markerIdx = conflict.end
+	default:
+		return ""
+	}
+
+	marker := strings.TrimPrefix(utils.SplitLines(s.GetContent())[markerIdx], "++")
+	return strings.TrimSpace(strings.TrimLeft(marker, "<|>"))
+}
+
+func (s *State) ResultLines() []string {
+	return lo.Map(s.threeWay().result, func(line resultLine, _ int) string { return line.text })
+}
+
+func (s *State) SelectedPane() Pane {
+	return s.threeWay().pane
+}
+
+// SelectedPaneLine returns the selected line index of the given pane
+func (s *State) SelectedPaneLine(pane Pane) int {
+	lineCount := len(s.PaneLines(pane))
+	if lineCount == 0 {
+		return 0
+	}
+	return utils.Clamp(s.threeWay().lineIndices[pane], 0, lineCount-1)
+}
+
+// IsLinePicked tells us whether the given line of one of the sides has been
+// added to the result
+func (s *State) IsLinePicked(pane Pane, index int) bool {
+	return lo.ContainsBy(s.threeWay().result, func(line resultLine) bool {
+		return line.pane == pane && line.index == index
+	})
+}
+
+// SelectNextPane selects the next pane that has any lines, wrapping around
+func (s *State) SelectNextPane() {
+	threeWay := s.threeWay()
+	for i := 1; i < len(allPanes); i++ {
+		pane := allPanes[(int(threeWay.pane)+i)%len(allPanes)]
+		if len(s.PaneLines(pane)) > 0 {
+			threeWay.pane = pane
+			return
+		}
+	}
+}
+
+func (s *State) SelectPrevPaneLine() {
+	s.selectPaneLine(s.SelectedPaneLine(s.SelectedPane()) - 1)
+}
+
+func (s *State) SelectNextPaneLine() {
+	s.selectPaneLine(s.SelectedPaneLine(s.SelectedPane()) + 1)
+}
+
+func (s *State) selectPaneLine(index int) {
+	threeWay := s.threeWay()
+	lineCount := len(s.PaneLines(threeWay.pane))
+	if lineCount == 0 {
+		return
+	}
+	threeWay.lineIndices[threeWay.pane] = utils.Clamp(index, 0, lineCount-1)
+}
+
+// TogglePickedLine adds the selected line of the selected side to the end of
+// the result, or removes it if it's already there. If the result pane is
+// selected, the selected line is removed from the result.
+func (s *State) TogglePickedLine() {
+	threeWay := s.threeWay()
+	pane := threeWay.pane
+	lines := s.PaneLines(pane)
+	if len(lines) == 0 {
+		return
+	}
+	index := s.SelectedPaneLine(pane)
+
+	if pane == RESULT {
+		s.removeResultLine(index)
+		return
+	}
+
+	if s.IsLinePicked(pane, index) {
+		threeWay.result = lo.Reject(threeWay.result, func(line resultLine, _ int) bool {
+			return line.pane == pane && line.index == index
+		})
+		return
+	}
+
+	threeWay.result = append(threeWay.result, resultLine{text: lines[index], pane: pane, index: index})
+}
+
+// PickAllPaneLines adds all lines of the selected side to the end of the
+// result, skipping the ones that are already there
+func (s *State) PickAllPaneLines() {
+	threeWay := s.threeWay()
+	pane := threeWay.pane
+	if pane == RESULT {
+		return
+	}
+
+	for index, text := range s.PaneLines(pane) {
+		if !s.IsLinePicked(pane, index) {
+			threeWay.result = append(threeWay.result, resultLine{text: text, pane: pane, index: index})
+		}
+	}
+}
+
+func (s *State) removeResultLine(index int) {
+	threeWay := s.threeWay()
+	threeWay.result = append(threeWay.result[:index], threeWay.result[index+1:]...)
+	if len(threeWay.result) == 0 {
+		threeWay.pane = s.firstNonEmptyPane()
+	}
+}
+
+// EditSelectedResultLine replaces the text of the selected line of the result
+// pane. Returns false if the result pane isn't selected.
+func (s *State) EditSelectedResultLine(text string) bool {
+	threeWay := s.threeWay()
+	if threeWay.pane != RESULT || len(threeWay.result) == 0 {
+		return false
+	}
+
+	threeWay.result[s.SelectedPaneLine(RESULT)] = resultLine{text: text, pane: RESULT}
+	return true
+}
+
+// SelectedResultLine returns the text of the selected line of the result pane
+func (s *State) SelectedResultLine() (string, bool) {
+	threeWay := s.threeWay()
+	if threeWay.pane != RESULT || len(threeWay.result) == 0 {
+		return "", false
+	}
+
+	return threeWay.result[s.SelectedPaneLine(RESULT)].text, true
+}
+
+// InsertResultLine adds a line to the result below the selected line of the
+// result pane (or at the end if the result pane isn't selected), and selects it
+func (s *State) InsertResultLine(text string) {
+	threeWay := s.threeWay()
+	index := len(threeWay.result)
+	if threeWay.pane == RESULT && len(threeWay.result) > 0 {
+		index = s.SelectedPaneLine(RESULT) + 1
+	}
+
+	threeWay.result = slices.Insert(threeWay.result, index, resultLine{text: text, pane: RESULT})
+	threeWay.pane = RESULT
+	threeWay.lineIndices[RESULT] = index
+}
+
+// ContentAfterThreeWayResolve returns the content of the file with the
+// selected conflict (including its markers) replaced by the lines of the
+// result pane
+func (s *State) ContentAfterThreeWayResolve() (bool, string, error) {
+	conflict := s.currentConflict()
+	if conflict == nil {
+		return false, "", nil
+	}
+
+	content := ""
+	err := utils.ForEachLineInFile(s.path, func(line string, i int) {
+		if i < conflict.start || conflict.end < i {
+			content += line
+		} else if i == conflict.start {
+			// use the same line ending as the rest of the file
+			lineEnding := line[len(strings.TrimRight(line, "\r\n")):]
+			for _, resultLine := range s.ResultLines() {
+				content += resultLine + lineEnding
+			}
+		}
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	return true, content, nil
+}
@@ -0,0 +1,118 @@
+package mergeconflicts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const diff3Content = `before
+<<<<<<< HEAD
+ours 1
+ours 2
+||||||| base
+base 1
+=======
+theirs 1
+>>>>>>> branch
+after
+`
+
+func newThreeWayTestState(t *testing.T, content string) *State {
+	path := filepath.Join(t.TempDir(), "file")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	state := NewState()
+	state.SetContent(content, path)
+	return state
+}
+
+func TestThreeWayPanes(t *testing.T) {
+	state := newThreeWayTestState(t, diff3Content)
+
+	assert.EqualValues(t, []string{"ours 1", "ours 2"}, state.PaneLines(OURS))
+	assert.EqualValues(t, []string{"base 1"}, state.PaneLines(BASE))
+	assert.EqualValues(t, []string{"theirs 1"}, state.PaneLines(THEIRS))
+	assert.EqualValues(t, []string{}, state.PaneLines(RESULT))
+
+	assert.Equal(t, "HEAD", state.PaneLabel(OURS))
+	assert.Equal(t, "base", state.PaneLabel(BASE))
+	assert.Equal(t, "branch", state.PaneLabel(THEIRS))
+}
+
+func TestThreeWayPanesWithoutBase(t *testing.T) {
+	state := newThreeWayTestState(t, "<<<<<<< HEAD\nours\n=======\ntheirs\n>>>>>>> branch\n")
+
+	assert.Empty(t, state.PaneLines(BASE))
+	assert.Equal(t, "", state.PaneLabel(BASE))
+
+	// the base pane is skipped because it has no lines, and so is the result
+	// pane while it's empty
+	assert.Equal(t, OURS, state.SelectedPane())
+	state.SelectNextPane()
+	assert.Equal(t, THEIRS, state.SelectedPane())
+	state.SelectNextPane()
+	assert.Equal(t, OURS, state.SelectedPane())
+}
+
+func TestThreeWayPickingLines(t *testing.T) {
+	state := newThreeWayTestState(t, diff3Content)
+
+	// pick the second line of ours
+	state.SelectNextPaneLine()
+	state.TogglePickedLine()
+	assert.True(t, state.IsLinePicked(OURS, 1))
+
+	// then all of theirs
+	state.SelectNextPane()
+	state.SelectNextPane()
+	assert.Equal(t, THEIRS, state.SelectedPane())
+	state.PickAllPaneLines()
+	assert.EqualValues(t, []string{"ours 2", "theirs 1"}, state.ResultLines())
+
+	// picking the same line again removes it
+	state.TogglePickedLine()
+	assert.EqualValues(t, []string{"ours 2"}, state.ResultLines())
+
+	// add a new line and edit it
+	state.InsertResultLine("new")
+	assert.Equal(t, RESULT, state.SelectedPane())
+	assert.Equal(t, 1, state.SelectedPaneLine(RESULT))
+	assert.True(t, state.EditSelectedResultLine("edited"))
+	assert.EqualValues(t, []string{"ours 2", "edited"}, state.ResultLines())
+
+	// picking in the result pane removes the selected line
+	state.SelectPrevPaneLine()
+	state.TogglePickedLine()
+	assert.EqualValues(t, []string{"edited"}, state.ResultLines())
+	assert.False(t, state.IsLinePicked(OURS, 1))
+
+	ok, content, err := state.ContentAfterThreeWayResolve()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "before\nedited\nafter\n", content)
+}
+
+func TestThreeWayStateIsResetForOtherConflicts(t *testing.T) {
+	state := newThreeWayTestState(t, diff3Content+diff3Content)
+
+	state.TogglePickedLine()
+	assert.EqualValues(t, []string{"ours 1"}, state.ResultLines())
+
+	state.SelectNextConflict()
+	assert.Empty(t, state.ResultLines())
+}
+
+func TestThreeWayResolveKeepsLineEndings(t *testing.T) {
+	state := newThreeWayTestState(t, "a\r\n<<<<<<< HEAD\r\nours\r\n=======\r\ntheirs\r\n>>>>>>> branch\r\nb\r\n")
+
+	state.PickAllPaneLines()
+	state.SelectNextPane()
+	state.PickAllPaneLines()
+
+	_, content, err := state.ContentAfterThreeWayResolve()
+	assert.NoError(t, err)
+	assert.Equal(t, "a\r\nours\r\ntheirs\r\nb\r\n", content)
+}
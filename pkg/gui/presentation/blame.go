@@ -0,0 +1,63 @@
+package presentation
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+const blameAuthorLength = 17
+
+func GetBlameLineListDisplayStrings(
+	lines []*models.BlameLine,
+	timeFormat string,
+	shortTimeFormat string,
+	now time.Time,
+	tr *i18n.TranslationSet,
+) [][]string {
+	return lo.Map(lines, func(line *models.BlameLine, _ int) []string {
+		return getBlameLineDisplayStrings(line, timeFormat, shortTimeFormat, now, tr)
+	})
+}
+
+// Only the first line of a group of lines that come from the same commit
+// shows the commit info; the other lines leave the gutter empty so that the
+// groups are easy to tell apart.
+func getBlameLineDisplayStrings(
+	line *models.BlameLine,
+	timeFormat string,
+	shortTimeFormat string,
+	now time.Time,
+	tr *i18n.TranslationSet,
+) []string {
+	hashString := ""
+	authorString := ""
+	dateString := ""
+	if line.IsFirstInGroup {
+		commit := line.Commit
+		if commit.IsCommitted() {
+			hashString = style.FgYellow.Sprint(commit.ShortHash())
+			authorString = authors.LongAuthor(commit.AuthorName, blameAuthorLength)
+			dateString = style.FgBlue.Sprint(
+				utils.UnixToDateSmart(now, commit.UnixTimestamp, timeFormat, shortTimeFormat),
+			)
+		} else {
+			hashString = style.FgRed.Sprint(utils.ShortHash(commit.Hash))
+			authorString = style.FgRed.Sprint(utils.TruncateWithEllipsis(tr.NotCommittedYet, blameAuthorLength))
+		}
+	}
+
+	return []string{
+		hashString,
+		authorString,
+		dateString,
+		style.FgCyan.Sprint(line.LineNumber),
+		theme.DefaultTextColor.Sprint(line.Content),
+	}
+}
@@ -30,6 +30,7 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		useIcons             bool
 		checkedOutByWorktree bool
 		showDivergenceCfg    string
+		pullRequest          *models.PullRequest
 		expected             []string
 	}{
 		// First some tests for when the view is wide enough so that everything fits:
@@ -315,6 +316,39 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			showDivergenceCfg:    "none",
 			expected:             []string{"1m", "12345678", "bran… ✓", "origin branch_name", "commit title"},
 		},
+		{
+			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            100,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pullRequest: &models.PullRequest{
+				Number:       12,
+				ReviewStatus: models.PullRequestReviewStatusApproved,
+				CheckStatus:  models.PullRequestCheckStatusFailure,
+			},
+			expected: []string{"1m", "branch_name #12 ✓ ●"},
+		},
+		{
+			branch: &models.Branch{
+				Name:           "branch_name",
+				Recency:        "1m",
+				UpstreamRemote: "origin",
+				UpstreamBranch: "branch_name",
+				AheadForPull:   "1",
+				BehindForPull:  "0",
+			},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            20,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "none",
+			pullRequest:          &models.PullRequest{Number: 3, IsDraft: true},
+			expected:             []string{"1m", "bra… ↑1 #3 draft"},
+		},
 	}
 
 	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
@@ -326,14 +360,20 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 	for i, s := range scenarios {
 		icons.SetNerdFontsVersion(lo.Ternary(s.useIcons, "3", ""))
 		c.UserConfig().Gui.ShowDivergenceFromBaseBranch = s.showDivergenceCfg
+		c.UserConfig().Git.PullRequests.ShowInBranchesPanel = s.pullRequest != nil
 
 		worktrees := []*models.Worktree{}
 		if s.checkedOutByWorktree {
 			worktrees = append(worktrees, &models.Worktree{Branch: s.branch.Name})
 		}
 
+		pullRequests := map[string]*models.PullRequest{}
+		if s.pullRequest != nil {
+			pullRequests[s.branch.Name] = s.pullRequest
+		}
+
 		t.Run(fmt.Sprintf("getBranchDisplayStrings_%d", i), func(t *testing.T) {
-			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, time.Time{})
+			strings := getBranchDisplayStrings(s.branch, s.itemOperation, s.fullDescription, false, s.viewWidth, c.Tr, c.UserConfig(), worktrees, pullRequests, time.Time{})
 			assert.Equal(t, s.expected, strings)
 		})
 	}
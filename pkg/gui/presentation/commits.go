@@ -419,6 +419,13 @@ func displayCommit(
 		}
 	}
 
+	noteString := ""
+	if commit.HasNote {
+		noteString = style.FgBlue.Sprint(lo.Ternary(icons.IsIconEnabled(), icons.NOTE_ICON, "✎")) + " "
+	}
+
+	signatureString := getSignatureString(commit, fullDescription)
+
 	name := commit.Name
 	if commit.Action == todo.UpdateRef {
 		name = strings.TrimPrefix(name, "refs/heads/")
@@ -455,12 +462,44 @@ func displayCommit(
 		descriptionString,
 		actionString,
 		author,
-		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
+		graphLine+mark+tagString+noteString+signatureString+theme.DefaultTextColor.Sprint(name),
 	)
 
 	return cols
 }
 
+// Shows git's %G? code (or an icon) for the signature status; in the full
+// description we also show who signed the commit
+func getSignatureString(commit *models.Commit, fullDescription bool) string {
+	var code string
+	var icon string
+	var color style.TextStyle
+	switch commit.SignatureStatus {
+	case models.SignatureStatusUnknown:
+		return ""
+	case models.SignatureStatusNone:
+		code, icon, color = "N", icons.UNSIGNED_COMMIT_ICON, style.FgRed
+	case models.SignatureStatusGood:
+		code, icon, color = "G", icons.SIGNED_COMMIT_ICON, style.FgGreen
+	case models.SignatureStatusUntrusted:
+		code, icon, color = "U", icons.SIGNED_COMMIT_ICON, style.FgYellow
+	case models.SignatureStatusExpired:
+		code, icon, color = "X", icons.SIGNED_COMMIT_ICON, style.FgYellow
+	case models.SignatureStatusRevoked:
+		code, icon, color = "R", icons.BAD_SIGNATURE_ICON, style.FgYellow
+	case models.SignatureStatusBad:
+		code, icon, color = "B", icons.BAD_SIGNATURE_ICON, style.FgRed
+	case models.SignatureStatusUnverifiable:
+		code, icon, color = "E", icons.SIGNED_COMMIT_ICON, style.FgYellow
+	}
+
+	result := lo.Ternary(icons.IsIconEnabled(), icon, code)
+	if fullDescription && commit.Signer != "" {
+		result += " " + commit.Signer
+	}
+	return color.Sprint(result) + " "
+}
+
 func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	switch status {
 	case BisectStatusNone:
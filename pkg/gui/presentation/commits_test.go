@@ -89,6 +89,62 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 commit2
 						`),
 		},
+		{
+			testName: "commit with note",
+			commits: []*models.Commit{
+				{Name: "commit1", Hash: "hash1", Tags: []string{"tag1"}, HasNote: true},
+				{Name: "commit2", Hash: "hash2"},
+			},
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 tag1 ✎ commit1
+		hash2 commit2
+						`),
+		},
+		{
+			testName: "commits with signature status",
+			commits: []*models.Commit{
+				{Name: "commit1", Hash: "hash1", SignatureStatus: models.SignatureStatusGood, Signer: "Jesse Duffield"},
+				{Name: "commit2", Hash: "hash2", SignatureStatus: models.SignatureStatusNone},
+				{Name: "commit3", Hash: "hash3", SignatureStatus: models.SignatureStatusBad, Signer: "Jesse Duffield"},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 G commit1
+		hash2 N commit2
+		hash3 B commit3
+		hash4 commit4
+						`),
+		},
+		{
+			testName: "commits with signature status, full description",
+			commits: []*models.Commit{
+				{Name: "commit1", Hash: "hash1", SignatureStatus: models.SignatureStatusGood, Signer: "Jesse Duffield"},
+				{Name: "commit2", Hash: "hash2", SignatureStatus: models.SignatureStatusNone},
+			},
+			fullDescription:           true,
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1                   G Jesse Duffield commit1
+		hash2                   N commit2
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commits: []*models.Commit{
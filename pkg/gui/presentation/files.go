@@ -21,6 +21,7 @@ const (
 func RenderFileTree(
 	tree filetree.IFileTree,
 	submoduleConfigs []*models.SubmoduleConfig,
+	sparseCheckout *models.SparseCheckout,
 	showFileIcons bool,
 	showNumstat bool,
 ) []string {
@@ -28,7 +29,7 @@ func RenderFileTree(
 	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, func(node *filetree.Node[models.File], treeDepth int, visualDepth int, isCollapsed bool) string {
 		fileNode := filetree.NewFileNode(node)
 
-		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, node)
+		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, sparseCheckout, node)
 	})
 }
 
@@ -115,6 +116,7 @@ func getFileLine(
 	showNumstat,
 	showFileIcons bool,
 	submoduleConfigs []*models.SubmoduleConfig,
+	sparseCheckout *models.SparseCheckout,
 	node *filetree.Node[models.File],
 ) string {
 	name := fileNameAtDepth(node, treeDepth)
@@ -167,6 +169,23 @@ func getFileLine(
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if isDirectory && sparseCheckout != nil && sparseCheckout.IsConeMode &&
+		filetree.IsOutsideSparseCheckoutCone(node.GetPath(), true, sparseCheckout.Patterns) {
+		output += theme.DefaultTextColor.Sprint(" (outside sparse-checkout cone)")
+	}
+
+	if file != nil && file.IsLfs {
+		output += theme.DefaultTextColor.Sprint(" (LFS")
+		if file.LfsLock != nil {
+			output += theme.DefaultTextColor.Sprint(", ") + style.FgYellow.Sprint("locked")
+		}
+		output += theme.DefaultTextColor.Sprint(")")
+	}
+
+	if file != nil && file.ResolvedByRerere {
+		output += theme.DefaultTextColor.Sprint(" (resolved by rerere)")
+	}
+
 	if file != nil && showNumstat {
 		if lineChanges := formatLineChanges(file.LinesAdded, file.LinesDeleted); lineChanges != "" {
 			output += " " + lineChanges
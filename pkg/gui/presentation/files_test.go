@@ -24,6 +24,7 @@ func TestRenderFileTree(t *testing.T) {
 		files           []*models.File
 		collapsedPaths  []string
 		showLineChanges bool
+		sparseCheckout  *models.SparseCheckout
 		expected        []string
 	}{
 		{
@@ -54,6 +55,45 @@ func TestRenderFileTree(t *testing.T) {
 				" M test4",
 			},
 		},
+		{
+			name: "lfs",
+			files: []*models.File{
+				{Name: "image.psd", ShortStatus: " M", HasStagedChanges: true, IsLfs: true},
+				{Name: "video.mp4", ShortStatus: " M", HasStagedChanges: true, IsLfs: true, LfsLock: &models.LfsLock{ID: "1", Path: "video.mp4"}},
+			},
+			expected: []string{
+				" M image.psd (LFS)",
+				" M video.mp4 (LFS, locked)",
+			},
+		},
+		{
+			name: "resolved by rerere",
+			files: []*models.File{
+				{Name: "resolved", ShortStatus: "UU", HasMergeConflicts: true, ResolvedByRerere: true},
+				{Name: "unresolved", ShortStatus: "UU", HasMergeConflicts: true},
+			},
+			expected: []string{
+				"UU resolved (resolved by rerere)",
+				"UU unresolved",
+			},
+		},
+		{
+			name: "sparse checkout",
+			files: []*models.File{
+				{Name: "pkg/app/main.go", ShortStatus: " M", HasStagedChanges: true},
+				{Name: "pkg/other/util.go", ShortStatus: "??", HasUnstagedChanges: true},
+			},
+			sparseCheckout: &models.SparseCheckout{IsConeMode: true, Patterns: []string{"pkg/app"}},
+			expected: toStringSlice(
+				`
+▼ pkg
+  ▼ app
+     M main.go
+  ▼ other (outside sparse-checkout cone)
+    ?? util.go
+`,
+			),
+		},
 		{
 			name: "big example",
 			files: []*models.File{
@@ -89,7 +129,7 @@ M  file1
 			for _, path := range s.collapsedPaths {
 				viewModel.ToggleCollapsed(path)
 			}
-			result := RenderFileTree(viewModel, nil, false, s.showLineChanges)
+			result := RenderFileTree(viewModel, nil, s.sparseCheckout, false, s.showLineChanges)
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stefanhaller/git-todo-parser/todo"
 )
 
 var (
@@ -16,6 +17,13 @@ var (
 	STASH_ICON                   = "\uf01c"     // 
 	LINKED_WORKTREE_ICON         = "\U000f0339" // 󰌹
 	MISSING_LINKED_WORKTREE_ICON = "\U000f033a" // 󰌺
+	NOTE_ICON                    = "\uf249"     // 
+	EXEC_TODO_ICON               = "\uf489"     // 
+	LABEL_TODO_ICON              = "\uf02e"     // 
+	RESET_TODO_ICON              = "\uf0e2"     // 
+	SIGNED_COMMIT_ICON           = "\uf023"     // 
+	UNSIGNED_COMMIT_ICON         = "\uf09c"     // 
+	BAD_SIGNATURE_ICON           = "\uf071"     // 
 )
 
 var remoteIcons = map[string]string{
@@ -62,6 +70,19 @@ func IconForTag(tag *models.Tag) string {
 }
 
 func IconForCommit(commit *models.Commit) string {
+	switch commit.Action {
+	case todo.UpdateRef:
+		return BRANCH_ICON
+	case todo.Exec:
+		return EXEC_TODO_ICON
+	case todo.Label:
+		return LABEL_TODO_ICON
+	case todo.Reset:
+		return RESET_TODO_ICON
+	case todo.Merge:
+		return MERGE_COMMIT_ICON
+	}
+
 	if len(commit.Parents) > 1 {
 		return MERGE_COMMIT_ICON
 	}
@@ -0,0 +1,40 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Summarises a change to a file tracked by Git LFS. We show this instead of
+// the diff of the pointer file, which is just a bunch of hashes. Either of
+// the pointers may be nil if the file was added or removed.
+func GetLfsPointerDiffSummary(oldPointer *models.LfsPointer, newPointer *models.LfsPointer, tr *i18n.TranslationSet) string {
+	var summary string
+	switch {
+	case oldPointer == nil:
+		summary = utils.ResolvePlaceholderString(tr.LfsObjectAdded, map[string]string{
+			"size": utils.FormatBytes(newPointer.Size),
+		})
+	case newPointer == nil:
+		summary = utils.ResolvePlaceholderString(tr.LfsObjectRemoved, map[string]string{
+			"size": utils.FormatBytes(oldPointer.Size),
+		})
+	default:
+		summary = utils.ResolvePlaceholderString(tr.LfsObjectChanged, map[string]string{
+			"oldSize": utils.FormatBytes(oldPointer.Size),
+			"newSize": utils.FormatBytes(newPointer.Size),
+		})
+	}
+
+	result := style.AttrBold.Sprint(summary) + "\n"
+	if oldPointer != nil {
+		result += "\n" + style.FgRed.Sprint("- "+oldPointer.Oid)
+	}
+	if newPointer != nil {
+		result += "\n" + style.FgGreen.Sprint("+ "+newPointer.Oid)
+	}
+
+	return result
+}
@@ -0,0 +1,51 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestGetLfsPointerDiffSummary(t *testing.T) {
+	oldPointer := &models.LfsPointer{Oid: "sha256:1111", Size: 1024}
+	newPointer := &models.LfsPointer{Oid: "sha256:2222", Size: 2048000}
+
+	scenarios := []struct {
+		name       string
+		oldPointer *models.LfsPointer
+		newPointer *models.LfsPointer
+		expected   string
+	}{
+		{
+			name:       "changed",
+			oldPointer: oldPointer,
+			newPointer: newPointer,
+			expected:   "LFS object changed (1.0 KB → 2.0 MB)\n\n- sha256:1111\n+ sha256:2222",
+		},
+		{
+			name:       "added",
+			oldPointer: nil,
+			newPointer: newPointer,
+			expected:   "LFS object added (2.0 MB)\n\n+ sha256:2222",
+		},
+		{
+			name:       "removed",
+			oldPointer: oldPointer,
+			newPointer: nil,
+			expected:   "LFS object removed (1.0 KB)\n\n- sha256:1111",
+		},
+	}
+
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, GetLfsPointerDiffSummary(s.oldPointer, s.newPointer, i18n.EnglishTranslationSet()))
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetOperationListDisplayStrings(operations []*models.Operation) [][]string {
+	return lo.Map(operations, func(operation *models.Operation, _ int) []string {
+		return getOperationDisplayStrings(operation)
+	})
+}
+
+func getOperationDisplayStrings(operation *models.Operation) []string {
+	// Only the first change fits on the line; the main view shows all of them
+	summary := ""
+	if len(operation.Changes) > 0 {
+		summary = operation.Changes[0]
+	}
+
+	return []string{
+		style.FgBlue.Sprint(utils.UnixToTimeAgo(operation.Timestamp)),
+		theme.DefaultTextColor.Sprint(operation.Action),
+		style.FgCyan.Sprint(summary),
+	}
+}
@@ -0,0 +1,41 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+)
+
+// Returns e.g. "#12 ✓ ●", where the first symbol is the review status and the
+// second one the status of the CI checks
+func PullRequestStatus(pr *models.PullRequest, tr *i18n.TranslationSet) string {
+	parts := []string{}
+
+	if pr.IsDraft {
+		parts = append(parts, style.FgDefault.Sprintf("#%d %s", pr.Number, tr.PullRequestDraft))
+	} else {
+		parts = append(parts, style.FgMagenta.Sprintf("#%d", pr.Number))
+	}
+
+	switch pr.ReviewStatus {
+	case models.PullRequestReviewStatusApproved:
+		parts = append(parts, style.FgGreen.Sprint("✓"))
+	case models.PullRequestReviewStatusChangesRequested:
+		parts = append(parts, style.FgRed.Sprint("✗"))
+	case models.PullRequestReviewStatusReviewRequired:
+		parts = append(parts, style.FgYellow.Sprint("…"))
+	}
+
+	switch pr.CheckStatus {
+	case models.PullRequestCheckStatusSuccess:
+		parts = append(parts, style.FgGreen.Sprint("●"))
+	case models.PullRequestCheckStatusFailure:
+		parts = append(parts, style.FgRed.Sprint("●"))
+	case models.PullRequestCheckStatusPending:
+		parts = append(parts, style.FgYellow.Sprint("●"))
+	}
+
+	return strings.Join(parts, " ")
+}
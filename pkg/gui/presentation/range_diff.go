@@ -0,0 +1,44 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/samber/lo"
+)
+
+func GetRangeDiffPairListDisplayStrings(pairs []*models.RangeDiffPair) [][]string {
+	return lo.Map(pairs, func(pair *models.RangeDiffPair, _ int) []string {
+		return getRangeDiffPairDisplayStrings(pair)
+	})
+}
+
+func getRangeDiffPairDisplayStrings(pair *models.RangeDiffPair) []string {
+	return []string{
+		rangeDiffCommitString(pair.OldHash),
+		rangeDiffStatusString(pair.Status),
+		rangeDiffCommitString(pair.NewHash),
+		theme.DefaultTextColor.Sprint(pair.Summary),
+	}
+}
+
+func rangeDiffCommitString(hash string) string {
+	if hash == "" {
+		return theme.DefaultTextColor.Sprint("-------")
+	}
+
+	return style.FgYellow.Sprint(hash)
+}
+
+func rangeDiffStatusString(status models.RangeDiffStatus) string {
+	switch status {
+	case models.RangeDiffChanged:
+		return style.FgYellow.Sprint("!")
+	case models.RangeDiffRemoved:
+		return style.FgRed.Sprint("<")
+	case models.RangeDiffAdded:
+		return style.FgGreen.Sprint(">")
+	default:
+		return theme.DefaultTextColor.Sprint("=")
+	}
+}
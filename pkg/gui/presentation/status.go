@@ -19,6 +19,7 @@ func FormatStatus(
 	itemOperation types.ItemOperation,
 	linkedWorktreeName string,
 	workingTreeState enums.RebaseMode,
+	isSparseCheckout bool,
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 ) string {
@@ -46,5 +47,9 @@ func FormatStatus(
 	}
 	status += fmt.Sprintf("%s → %s", repoName, name)
 
+	if isSparseCheckout {
+		status += style.FgCyan.Sprintf(" (%s)", tr.SparseCheckoutStatus)
+	}
+
 	return status
 }
@@ -18,12 +18,15 @@ func GetTagListDisplayStrings(
 	tags []*models.Tag,
 	getItemOperation func(item types.HasUrn) types.ItemOperation,
 	diffName string,
+	remoteTagNames map[string]bool,
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 ) [][]string {
 	return lo.Map(tags, func(tag *models.Tag, _ int) []string {
 		diffed := tag.Name == diffName
-		return getTagDisplayStrings(tag, getItemOperation(tag), diffed, tr, userConfig)
+		// We only know which tags the remote is missing once we've asked it
+		notOnRemote := remoteTagNames != nil && !remoteTagNames[tag.Name]
+		return getTagDisplayStrings(tag, getItemOperation(tag), diffed, notOnRemote, tr, userConfig)
 	})
 }
 
@@ -32,6 +35,7 @@ func getTagDisplayStrings(
 	t *models.Tag,
 	itemOperation types.ItemOperation,
 	diffed bool,
+	notOnRemote bool,
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 ) []string {
@@ -49,6 +53,10 @@ func getTagDisplayStrings(
 	if itemOperationStr != "" {
 		descriptionStr = style.FgCyan.Sprint(itemOperationStr+" "+utils.Loader(time.Now(), userConfig.Gui.Spinner)) + " " + descriptionStr
 	}
-	res = append(res, textStyle.Sprint(t.Name), descriptionStr)
+	nameStr := textStyle.Sprint(t.Name)
+	if notOnRemote {
+		nameStr += " " + style.FgGreen.Sprint("↑")
+	}
+	res = append(res, nameStr, descriptionStr)
 	return res
 }
@@ -1,11 +1,16 @@
 package presentation
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -47,5 +52,65 @@ func GetWorktreeDisplayString(tr *i18n.TranslationSet, worktree *models.Worktree
 		name += " " + tr.MissingWorktree
 	}
 	res = append(res, textStyle.Sprint(name))
+
+	branch := style.FgYellow.Sprint(worktree.Branch)
+	if worktree.Branch == "" {
+		branch = style.FgDefault.Sprint(tr.WorktreeDetached)
+	}
+	res = append(res, branch)
+
+	res = append(res, WorktreeStatusString(tr, worktree))
 	return res
 }
+
+// Returns a short summary of the worktree's state, e.g. "(rebasing) 3 changed ↓1↑2".
+// Empty while the status is still loading.
+func WorktreeStatusString(tr *i18n.TranslationSet, worktree *models.Worktree) string {
+	status := worktree.Status.Load()
+	if status == nil {
+		return ""
+	}
+
+	parts := []string{}
+
+	switch status.WorkingTreeState {
+	case enums.REBASE_MODE_REBASING:
+		parts = append(parts, style.FgYellow.Sprintf("(%s)", tr.LowercaseRebasingStatus))
+	case enums.REBASE_MODE_MERGING:
+		parts = append(parts, style.FgYellow.Sprintf("(%s)", tr.LowercaseMergingStatus))
+	}
+
+	if status.ConflictedFiles > 0 {
+		parts = append(parts, style.FgRed.Sprint(utils.ResolvePlaceholderString(
+			tr.WorktreeConflictedFiles,
+			map[string]string{"count": fmt.Sprint(status.ConflictedFiles)},
+		)))
+	}
+
+	if status.IsDirty() {
+		parts = append(parts, style.FgMagenta.Sprint(utils.ResolvePlaceholderString(
+			tr.WorktreeChangedFiles,
+			map[string]string{"count": fmt.Sprint(status.ChangedFiles)},
+		)))
+	}
+
+	if status.HasUpstream {
+		parts = append(parts, worktreeAheadBehindString(status))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Uses the same notation as the branches panel
+func worktreeAheadBehindString(status *models.WorktreeStatus) string {
+	switch {
+	case status.Ahead == 0 && status.Behind == 0:
+		return style.FgGreen.Sprint("✓")
+	case status.Behind == 0:
+		return style.FgYellow.Sprintf("↑%d", status.Ahead)
+	case status.Ahead == 0:
+		return style.FgYellow.Sprintf("↓%d", status.Behind)
+	default:
+		return style.FgYellow.Sprintf("↓%d↑%d", status.Behind, status.Ahead)
+	}
+}
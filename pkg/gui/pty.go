@@ -51,7 +51,7 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 
 	if pager == "" && externalDiffCommand == "" {
 		// if we're not using a custom pager we don't need to use a pty
-		return gui.newCmdTask(view, cmd, prefix)
+		return gui.newCmdTask(view, cmd, prefix, nil)
 	}
 
 	cmdStr := strings.Join(cmd.Args, " ")
@@ -14,5 +14,5 @@ func (gui *Gui) onResize() error {
 }
 
 func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
-	return gui.newCmdTask(view, cmd, prefix)
+	return gui.newCmdTask(view, cmd, prefix, nil)
 }
@@ -93,10 +93,16 @@ func (self *Client) showCustomCommandsMenu(customCommand config.CustomCommand) e
 				}
 			}
 
+			var disabledReason *types.DisabledReason
+			if subCommand.RequireRangeSelect {
+				disabledReason = self.keybindingCreator.getDisabledReasonForRangeSelect()
+			}
+
 			menuItems = append(menuItems, &types.MenuItem{
-				Label:   subCommand.GetDescription(),
-				Key:     keybindings.GetKey(subCommand.Key),
-				OnPress: self.handlerCreator.call(subCommand),
+				Label:          subCommand.GetDescription(),
+				Key:            keybindings.GetKey(subCommand.Key),
+				OnPress:        self.handlerCreator.call(subCommand),
+				DisabledReason: disabledReason,
 			})
 		}
 	}
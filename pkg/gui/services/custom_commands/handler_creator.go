@@ -7,7 +7,9 @@ import (
 	"text/template"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -48,7 +50,16 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 	return func() error {
 		sessionState := self.sessionStateLoader.call()
 		promptResponses := make([]string, len(customCommand.Prompts))
-		form := make(map[string]string)
+		form := make(map[string]any)
+		// Initialise the form so that templates referring to prompts that haven't
+		// been answered yet resolve to an empty value rather than "<no value>"
+		for _, prompt := range customCommand.Prompts {
+			if prompt.Type == "multiSelect" {
+				form[prompt.Key] = []string{}
+			} else {
+				form[prompt.Key] = ""
+			}
+		}
 
 		f := func() error { return self.finalHandler(customCommand, sessionState, promptResponses, form) }
 
@@ -68,7 +79,13 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 				return g()
 			}
 
-			resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState)
+			wrappedMultiSelectF := func(responses []string) error {
+				promptResponses[idx] = strings.Join(responses, " ")
+				form[prompt.Key] = responses
+				return g()
+			}
+
+			resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState, "")
 
 			switch prompt.Type {
 			case "input":
@@ -95,6 +112,14 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 					}
 					return self.menuPromptFromCommand(resolvedPrompt, wrappedF)
 				}
+			case "multiSelect":
+				f = func() error {
+					resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
+					if err != nil {
+						return err
+					}
+					return self.multiSelectPrompt(resolvedPrompt, wrappedMultiSelectF)
+				}
 			case "confirm":
 				f = func() error {
 					resolvedPrompt, err := self.resolver.resolvePrompt(&prompt, resolveTemplate)
@@ -104,7 +129,7 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 					return self.confirmPrompt(resolvedPrompt, g)
 				}
 			default:
-				return errors.New("custom command prompt must have a type of 'input', 'menu', 'menuFromCommand', or 'confirm'")
+				return errors.New("custom command prompt must have a type of 'input', 'menu', 'menuFromCommand', 'multiSelect', or 'confirm'")
 			}
 		}
 
@@ -232,17 +257,82 @@ func (self *HandlerCreator) menuPromptFromCommand(prompt *config.CustomCommandPr
 	return self.c.Menu(types.CreateMenuOptions{Title: prompt.Title, Items: menuItems})
 }
 
+func (self *HandlerCreator) multiSelectPrompt(prompt *config.CustomCommandPrompt, wrappedF func([]string) error) error {
+	type candidate struct {
+		labelColumns []string
+		value        string
+	}
+
+	var candidates []candidate
+	if prompt.Command != "" {
+		message, err := self.c.Git().Custom.RunWithOutput(prompt.Command)
+		if err != nil {
+			return err
+		}
+
+		menuCandidates, err := self.menuGenerator.call(message, prompt.Filter, prompt.ValueFormat, prompt.LabelFormat)
+		if err != nil {
+			return err
+		}
+
+		candidates = lo.Map(menuCandidates, func(menuCandidate *commandMenuItem, _ int) candidate {
+			return candidate{labelColumns: []string{menuCandidate.label}, value: menuCandidate.value}
+		})
+	} else {
+		candidates = lo.Map(prompt.Options, func(option config.CustomCommandMenuOption, _ int) candidate {
+			return candidate{
+				labelColumns: []string{option.Name, style.FgYellow.Sprint(option.Description)},
+				value:        option.Value,
+			}
+		})
+	}
+
+	selected := make([]bool, len(candidates))
+
+	menuItems := lo.Map(candidates, func(candidate candidate, idx int) *types.MenuItem {
+		item := &types.MenuItem{
+			LabelColumns: candidate.labelColumns,
+			Widget:       types.MakeMenuCheckBox(false),
+			KeepOpen:     true,
+		}
+		item.OnPress = func() error {
+			selected[idx] = !selected[idx]
+			item.Widget = types.MakeMenuCheckBox(selected[idx])
+			return nil
+		}
+		return item
+	})
+
+	menuItems = append(menuItems, &types.MenuItem{
+		LabelColumns: []string{style.FgGreen.Sprint(self.c.Tr.ConfirmSelection)},
+		OnPress: func() error {
+			values := lo.FilterMap(candidates, func(candidate candidate, idx int) (string, bool) {
+				return candidate.value, selected[idx]
+			})
+			return wrappedF(values)
+		},
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: prompt.Title, Items: menuItems})
+}
+
 type CustomCommandObjects struct {
 	*SessionState
 	PromptResponses []string
-	Form            map[string]string
+	// Values are strings, except for multiSelect prompts, where they are
+	// slices of strings
+	Form map[string]any
+	// The output of the command, with surrounding whitespace trimmed. Only
+	// available in the templates of the `after` hook.
+	Output string
 }
 
-func (self *HandlerCreator) getResolveTemplateFn(form map[string]string, promptResponses []string, sessionState *SessionState) func(string) (string, error) {
+func (self *HandlerCreator) getResolveTemplateFn(form map[string]any, promptResponses []string, sessionState *SessionState, output string) func(string) (string, error) {
 	objects := CustomCommandObjects{
 		SessionState:    sessionState,
 		PromptResponses: promptResponses,
 		Form:            form,
+		Output:          output,
 	}
 
 	funcs := template.FuncMap{
@@ -252,8 +342,8 @@ func (self *HandlerCreator) getResolveTemplateFn(form map[string]string, promptR
 	return func(templateStr string) (string, error) { return utils.ResolveTemplate(templateStr, objects, funcs) }
 }
 
-func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, sessionState *SessionState, promptResponses []string, form map[string]string) error {
-	resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState)
+func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, sessionState *SessionState, promptResponses []string, form map[string]any) error {
+	resolveTemplate := self.getResolveTemplateFn(form, promptResponses, sessionState, "")
 	cmdStr, err := resolveTemplate(customCommand.Command)
 	if err != nil {
 		return err
@@ -278,7 +368,12 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 		}
 		output, err := cmdObj.RunWithOutput()
 
-		if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC}); err != nil {
+		hasAfterActions := err == nil && customCommand.After != nil && customCommand.After.HasActions()
+
+		// If there are actions to run afterwards, they need to see the refreshed state
+		refreshMode := lo.Ternary(hasAfterActions, types.SYNC, types.ASYNC)
+		refreshScope := refreshScopeForAfterHook(customCommand.After)
+		if refreshErr := self.c.Refresh(types.RefreshOptions{Mode: refreshMode, Scope: refreshScope}); refreshErr != nil {
 			self.c.Log.Error(refreshErr)
 		}
 
@@ -290,21 +385,195 @@ func (self *HandlerCreator) finalHandler(customCommand config.CustomCommand, ses
 			return err
 		}
 
+		outputTitle := cmdStr
+		if customCommand.OutputTitle != "" {
+			outputTitle, err = resolveTemplate(customCommand.OutputTitle)
+			if err != nil {
+				return err
+			}
+		}
+
+		if customCommand.OutputList != nil {
+			items, err := self.customListItemsFromOutput(output, customCommand.OutputList)
+			if err != nil {
+				return err
+			}
+
+			self.c.OnUIThread(func() error {
+				self.showCustomList(items, outputTitle, customCommand.Name)
+				return nil
+			})
+		}
+
 		if customCommand.ShowOutput != nil && *customCommand.ShowOutput {
 			if strings.TrimSpace(output) == "" {
 				output = self.c.Tr.EmptyOutput
 			}
 
-			title := cmdStr
-			if customCommand.OutputTitle != "" {
-				title, err = resolveTemplate(customCommand.OutputTitle)
-				if err != nil {
-					return err
-				}
-			}
-			self.c.Alert(title, output)
+			self.c.Alert(outputTitle, output)
+		}
+
+		if hasAfterActions {
+			resolveTemplateWithOutput := self.getResolveTemplateFn(form, promptResponses, sessionState, strings.TrimSpace(output))
+			self.c.OnUIThread(func() error {
+				return self.runAfterActions(customCommand.After, resolveTemplateWithOutput)
+			})
 		}
 
 		return nil
 	})
 }
+
+func (self *HandlerCreator) customListItemsFromOutput(output string, outputList *config.CustomCommandOutputList) ([]*models.CustomListItem, error) {
+	lines := lo.Filter(strings.Split(output, "\n"), func(line string, _ int) bool { return line != "" })
+
+	candidates, err := self.menuGenerator.call(output, outputList.Filter, outputList.ValueFormat, outputList.LabelFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	// The menu generator skips empty lines, so the candidates correspond to the
+	// non-empty lines of the output
+	return lo.Map(candidates, func(candidate *commandMenuItem, idx int) *models.CustomListItem {
+		return &models.CustomListItem{
+			Label: candidate.label,
+			Value: candidate.value,
+			Line:  lines[idx],
+			Index: idx,
+		}
+	}), nil
+}
+
+func (self *HandlerCreator) showCustomList(items []*models.CustomListItem, title string, source string) {
+	customListContext := self.c.Contexts().CustomList
+
+	parentContext := self.c.Context().CurrentSide()
+	// If the command was run from the list itself (e.g. to refresh it), keep
+	// the selection and the original parent context
+	rerunFromList := parentContext == customListContext
+
+	customListContext.SetItems(items, source)
+	if rerunFromList {
+		customListContext.ClampSelection()
+	} else {
+		customListContext.SetSelection(0)
+		customListContext.SetParentContext(parentContext)
+		customListContext.SetWindowName(parentContext.GetWindowName())
+		customListContext.ClearSearchString()
+		customListContext.GetView().ClearSearch()
+	}
+	customListContext.GetView().Title = title
+
+	self.c.PostRefreshUpdate(customListContext)
+
+	self.c.Context().Push(customListContext)
+}
+
+var refreshScopesByName = map[string]types.RefreshableView{
+	"files":      types.FILES,
+	"branches":   types.BRANCHES,
+	"commits":    types.COMMITS,
+	"subCommits": types.SUB_COMMITS,
+	"reflog":     types.REFLOG,
+	"stash":      types.STASH,
+	"tags":       types.TAGS,
+	"remotes":    types.REMOTES,
+	"worktrees":  types.WORKTREES,
+	"submodules": types.SUBMODULES,
+	"status":     types.STATUS,
+}
+
+// Returns nil (i.e. refresh everything) unless the hook specifies scopes
+func refreshScopeForAfterHook(after *config.CustomCommandAfterHook) []types.RefreshableView {
+	if after == nil || len(after.Refresh) == 0 {
+		return nil
+	}
+
+	return lo.FilterMap(after.Refresh, func(name string, _ int) (types.RefreshableView, bool) {
+		scope, ok := refreshScopesByName[name]
+		return scope, ok
+	})
+}
+
+func (self *HandlerCreator) runAfterActions(after *config.CustomCommandAfterHook, resolveTemplate func(string) (string, error)) error {
+	targetContext := self.c.Context().Current()
+	if after.Focus != "" {
+		ctx, ok := contextForContextKey(self.c, types.ContextKey(after.Focus))
+		if !ok {
+			return fmt.Errorf("Unknown context '%s' in after.focus of custom command", after.Focus)
+		}
+		targetContext = ctx
+	}
+
+	if after.SelectRef != "" {
+		ref, err := resolveTemplate(after.SelectRef)
+		if err != nil {
+			return err
+		}
+
+		if err := self.selectRef(targetContext, ref); err != nil {
+			return err
+		}
+	}
+
+	if after.Focus != "" {
+		self.c.Context().Push(targetContext)
+	} else if after.SelectRef != "" {
+		self.c.PostRefreshUpdate(targetContext)
+	}
+
+	if after.Toast != "" {
+		message, err := resolveTemplate(after.Toast)
+		if err != nil {
+			return err
+		}
+		self.c.Toast(message)
+	}
+
+	if after.RunCommand != "" {
+		customCommand, ok := config.FindCustomCommandByName(self.c.UserConfig().CustomCommands, after.RunCommand)
+		if !ok {
+			return fmt.Errorf("Unknown custom command '%s' in after.runCommand", after.RunCommand)
+		}
+		return self.call(customCommand)()
+	}
+
+	return nil
+}
+
+func (self *HandlerCreator) selectRef(ctx types.Context, ref string) error {
+	commitMatchesRef := func(commit *models.Commit) bool {
+		return ref != "" && strings.HasPrefix(commit.Hash, ref)
+	}
+
+	idx := -1
+	switch ctx.GetKey() {
+	case context.LOCAL_BRANCHES_CONTEXT_KEY:
+		_, idx, _ = lo.FindIndexOf(self.c.Contexts().Branches.GetItems(), func(branch *models.Branch) bool {
+			return branch.Name == ref
+		})
+	case context.REMOTE_BRANCHES_CONTEXT_KEY:
+		_, idx, _ = lo.FindIndexOf(self.c.Contexts().RemoteBranches.GetItems(), func(branch *models.RemoteBranch) bool {
+			return branch.Name == ref || branch.FullName() == ref
+		})
+	case context.TAGS_CONTEXT_KEY:
+		_, idx, _ = lo.FindIndexOf(self.c.Contexts().Tags.GetItems(), func(tag *models.Tag) bool {
+			return tag.Name == ref
+		})
+	case context.LOCAL_COMMITS_CONTEXT_KEY:
+		_, idx, _ = lo.FindIndexOf(self.c.Contexts().LocalCommits.GetItems(), commitMatchesRef)
+	case context.SUB_COMMITS_CONTEXT_KEY:
+		_, idx, _ = lo.FindIndexOf(self.c.Contexts().SubCommits.GetItems(), commitMatchesRef)
+	case context.REFLOG_COMMITS_CONTEXT_KEY:
+		_, idx, _ = lo.FindIndexOf(self.c.Contexts().ReflogCommits.GetItems(), commitMatchesRef)
+	default:
+		return fmt.Errorf("after.selectRef of custom command is not supported in the '%s' context", ctx.GetKey())
+	}
+
+	if idx == -1 {
+		return fmt.Errorf("Could not find '%s' in the '%s' context", ref, ctx.GetKey())
+	}
+
+	ctx.(types.IListContext).GetList().SetSelection(idx)
+	return nil
+}
@@ -35,16 +35,29 @@ func (self *KeybindingCreator) call(customCommand config.CustomCommand, handler
 	}
 
 	return lo.Map(viewNames, func(viewName string, _ int) *types.Binding {
-		return &types.Binding{
+		binding := &types.Binding{
 			ViewName:    viewName,
 			Key:         keybindings.GetKey(customCommand.Key),
 			Modifier:    gocui.ModNone,
 			Handler:     handler,
 			Description: customCommand.GetDescription(),
 		}
+		if customCommand.RequireRangeSelect {
+			binding.GetDisabledReason = self.getDisabledReasonForRangeSelect
+		}
+		return binding
 	}), nil
 }
 
+func (self *KeybindingCreator) getDisabledReasonForRangeSelect() *types.DisabledReason {
+	listContext, ok := self.c.Context().Current().(types.IListContext)
+	if !ok || !listContext.GetList().AreMultipleItemsSelected() {
+		return &types.DisabledReason{Text: self.c.Tr.CustomCommandRequiresRangeSelect}
+	}
+
+	return nil
+}
+
 func (self *KeybindingCreator) getViewNamesAndContexts(customCommand config.CustomCommand) ([]string, error) {
 	if customCommand.Context == "global" {
 		return []string{""}, nil
@@ -57,7 +70,7 @@ func (self *KeybindingCreator) getViewNamesAndContexts(customCommand config.Cust
 
 	viewNames := []string{}
 	for _, context := range contexts {
-		ctx, ok := self.contextForContextKey(types.ContextKey(context))
+		ctx, ok := contextForContextKey(self.c, types.ContextKey(context))
 		if !ok {
 			return []string{}, formatUnknownContextError(customCommand)
 		}
@@ -68,8 +81,8 @@ func (self *KeybindingCreator) getViewNamesAndContexts(customCommand config.Cust
 	return viewNames, nil
 }
 
-func (self *KeybindingCreator) contextForContextKey(contextKey types.ContextKey) (types.Context, bool) {
-	for _, context := range self.c.Contexts().Flatten() {
+func contextForContextKey(c *helpers.HelperCommon, contextKey types.ContextKey) (types.Context, bool) {
+	for _, context := range c.Contexts().Flatten() {
 		if context.GetKey() == contextKey {
 			return context, true
 		}
@@ -98,3 +98,10 @@ type Worktree struct {
 	Branch        string
 	Name          string
 }
+
+type CustomListItem struct {
+	Label string
+	Value string
+	// The name of the custom command that produced the list
+	Source string
+}
@@ -162,6 +162,18 @@ func worktreeShimFromModelRemote(worktree *models.Worktree) *Worktree {
 	}
 }
 
+func customListItemShimFromModel(item *models.CustomListItem, source string) *CustomListItem {
+	if item == nil {
+		return nil
+	}
+
+	return &CustomListItem{
+		Label:  item.Label,
+		Value:  item.Value,
+		Source: source,
+	}
+}
+
 type CommitRange struct {
 	From string
 	To   string
@@ -178,6 +190,33 @@ func makeCommitRange(commits []*models.Commit, _ int, _ int) *CommitRange {
 	}
 }
 
+func shimsFromModels[M any, S any](models []M, shimFromModel func(M) S) []S {
+	return lo.Map(models, func(model M, _ int) S { return shimFromModel(model) })
+}
+
+func shimsFromSelectedItems[M any, S any](list interface{ GetSelectedItems() ([]M, int, int) }, shimFromModel func(M) S) []S {
+	items, _, _ := list.GetSelectedItems()
+	return shimsFromModels(items, shimFromModel)
+}
+
+// Returns the files contained in the given nodes (including the files in
+// selected directories), without duplicates
+func filesFromNodes[N interface {
+	comparable
+	ForEachFile(func(*T) error) error
+}, T any](nodes []N, getPath func(*T) string) []*T {
+	files := []*T{}
+	// The nodes can be nil if the selection is out of date
+	for _, node := range lo.Compact(nodes) {
+		_ = node.ForEachFile(func(file *T) error {
+			files = append(files, file)
+			return nil
+		})
+	}
+
+	return lo.UniqBy(files, getPath)
+}
+
 // SessionState captures the current state of the application for use in custom commands
 type SessionState struct {
 	SelectedLocalCommit    *Commit // deprecated, use SelectedCommit
@@ -195,7 +234,21 @@ type SessionState struct {
 	SelectedCommitFile     *CommitFile
 	SelectedCommitFilePath string
 	SelectedWorktree       *Worktree
+	SelectedCustomListItem *CustomListItem
 	CheckedOutBranch       *Branch
+
+	// All items of a range selection in the respective panels. If no range
+	// is selected, these contain just the selected item.
+	SelectedCommits         []*Commit
+	SelectedFiles           []*File
+	SelectedLocalBranches   []*Branch
+	SelectedRemoteBranches  []*RemoteBranch
+	SelectedRemotes         []*Remote
+	SelectedTags            []*Tag
+	SelectedStashEntries    []*StashEntry
+	SelectedCommitFiles     []*CommitFile
+	SelectedWorktrees       []*Worktree
+	SelectedCustomListItems []*CustomListItem
 }
 
 func (self *SessionStateLoader) call() *SessionState {
@@ -208,12 +261,15 @@ func (self *SessionStateLoader) call() *SessionState {
 
 	selectedCommit := selectedLocalCommit
 	selectedCommitRange := selectedLocalCommitRange
+	selectedCommits := shimsFromSelectedItems(self.c.Contexts().LocalCommits, commitShimFromModelCommit)
 	if self.c.Context().IsCurrentOrParent(self.c.Contexts().ReflogCommits) {
 		selectedCommit = selectedReflogCommit
 		selectedCommitRange = selectedReflogCommitRange
+		selectedCommits = shimsFromSelectedItems(self.c.Contexts().ReflogCommits, commitShimFromModelCommit)
 	} else if self.c.Context().IsCurrentOrParent(self.c.Contexts().SubCommits) {
 		selectedCommit = selectedSubCommit
 		selectedCommitRange = selectedSubCommitRange
+		selectedCommits = shimsFromSelectedItems(self.c.Contexts().SubCommits, commitShimFromModelCommit)
 	}
 
 	selectedPath := self.c.Contexts().Files.GetSelectedPath()
@@ -223,22 +279,43 @@ func (self *SessionStateLoader) call() *SessionState {
 		selectedPath = selectedCommitFilePath
 	}
 
+	customListSource := self.c.Contexts().CustomList.GetSource()
+	customListItemShim := func(item *models.CustomListItem) *CustomListItem {
+		return customListItemShimFromModel(item, customListSource)
+	}
+
+	fileNodes, _, _ := self.c.Contexts().Files.GetSelectedItems()
+	selectedFiles := filesFromNodes(fileNodes, func(file *models.File) string { return file.Name })
+	commitFileNodes, _, _ := self.c.Contexts().CommitFiles.GetSelectedItems()
+	selectedCommitFiles := filesFromNodes(commitFileNodes, func(file *models.CommitFile) string { return file.Name })
+
 	return &SessionState{
-		SelectedFile:           fileShimFromModelFile(self.c.Contexts().Files.GetSelectedFile()),
-		SelectedPath:           selectedPath,
-		SelectedLocalCommit:    selectedLocalCommit,
-		SelectedReflogCommit:   selectedReflogCommit,
-		SelectedSubCommit:      selectedSubCommit,
-		SelectedCommit:         selectedCommit,
-		SelectedCommitRange:    selectedCommitRange,
-		SelectedLocalBranch:    branchShimFromModelBranch(self.c.Contexts().Branches.GetSelected()),
-		SelectedRemoteBranch:   remoteBranchShimFromModelRemoteBranch(self.c.Contexts().RemoteBranches.GetSelected()),
-		SelectedRemote:         remoteShimFromModelRemote(self.c.Contexts().Remotes.GetSelected()),
-		SelectedTag:            tagShimFromModelRemote(self.c.Contexts().Tags.GetSelected()),
-		SelectedStashEntry:     stashEntryShimFromModelRemote(self.c.Contexts().Stash.GetSelected()),
-		SelectedCommitFile:     commitFileShimFromModelRemote(self.c.Contexts().CommitFiles.GetSelectedFile()),
-		SelectedCommitFilePath: selectedCommitFilePath,
-		SelectedWorktree:       worktreeShimFromModelRemote(self.c.Contexts().Worktrees.GetSelected()),
-		CheckedOutBranch:       branchShimFromModelBranch(self.refsHelper.GetCheckedOutRef()),
+		SelectedFile:            fileShimFromModelFile(self.c.Contexts().Files.GetSelectedFile()),
+		SelectedPath:            selectedPath,
+		SelectedLocalCommit:     selectedLocalCommit,
+		SelectedReflogCommit:    selectedReflogCommit,
+		SelectedSubCommit:       selectedSubCommit,
+		SelectedCommit:          selectedCommit,
+		SelectedCommitRange:     selectedCommitRange,
+		SelectedLocalBranch:     branchShimFromModelBranch(self.c.Contexts().Branches.GetSelected()),
+		SelectedRemoteBranch:    remoteBranchShimFromModelRemoteBranch(self.c.Contexts().RemoteBranches.GetSelected()),
+		SelectedRemote:          remoteShimFromModelRemote(self.c.Contexts().Remotes.GetSelected()),
+		SelectedTag:             tagShimFromModelRemote(self.c.Contexts().Tags.GetSelected()),
+		SelectedStashEntry:      stashEntryShimFromModelRemote(self.c.Contexts().Stash.GetSelected()),
+		SelectedCommitFile:      commitFileShimFromModelRemote(self.c.Contexts().CommitFiles.GetSelectedFile()),
+		SelectedCommitFilePath:  selectedCommitFilePath,
+		SelectedWorktree:        worktreeShimFromModelRemote(self.c.Contexts().Worktrees.GetSelected()),
+		SelectedCustomListItem:  customListItemShim(self.c.Contexts().CustomList.GetSelected()),
+		CheckedOutBranch:        branchShimFromModelBranch(self.refsHelper.GetCheckedOutRef()),
+		SelectedCommits:         selectedCommits,
+		SelectedFiles:           shimsFromModels(selectedFiles, fileShimFromModelFile),
+		SelectedLocalBranches:   shimsFromSelectedItems(self.c.Contexts().Branches, branchShimFromModelBranch),
+		SelectedRemoteBranches:  shimsFromSelectedItems(self.c.Contexts().RemoteBranches, remoteBranchShimFromModelRemoteBranch),
+		SelectedRemotes:         shimsFromSelectedItems(self.c.Contexts().Remotes, remoteShimFromModelRemote),
+		SelectedTags:            shimsFromSelectedItems(self.c.Contexts().Tags, tagShimFromModelRemote),
+		SelectedStashEntries:    shimsFromSelectedItems(self.c.Contexts().Stash, stashEntryShimFromModelRemote),
+		SelectedCommitFiles:     shimsFromModels(selectedCommitFiles, commitFileShimFromModelRemote),
+		SelectedWorktrees:       shimsFromSelectedItems(self.c.Contexts().Worktrees, worktreeShimFromModelRemote),
+		SelectedCustomListItems: shimsFromSelectedItems(self.c.Contexts().CustomList, customListItemShim),
 	}
 }
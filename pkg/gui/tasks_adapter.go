@@ -9,7 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/tasks"
 )
 
-func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string, formatOutput func(string) string) error {
 	cmdStr := strings.Join(cmd.Args, " ")
 	gui.c.Log.WithField(
 		"command",
@@ -29,6 +29,14 @@ func (gui *Gui) newCmdTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 			gui.c.Log.Error(err)
 		}
 
+		if formatOutput != nil {
+			output, err := io.ReadAll(r)
+			if err != nil {
+				gui.c.Log.Error(err)
+			}
+			return cmd, strings.NewReader(formatOutput(string(output)))
+		}
+
 		return cmd, r
 	}
 
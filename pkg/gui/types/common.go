@@ -236,6 +236,10 @@ type MenuItem struct {
 	// Only applies when Label is used
 	OpensMenu bool
 
+	// If true, the menu is not closed when the item is pressed, but re-rendered
+	// instead. Useful for toggling checkboxes.
+	KeepOpen bool
+
 	// If Key is defined it allows the user to press the key to invoke the menu
 	// item, as opposed to having to navigate to it
 	Key Key
@@ -279,6 +283,9 @@ type Model struct {
 	Remotes      []*models.Remote
 	Worktrees    []*models.Worktree
 
+	// nil if the worktree is not sparse
+	SparseCheckout *models.SparseCheckout
+
 	// FilteredReflogCommits are the ones that appear in the reflog panel.
 	// when in filtering mode we only include the ones that match the given path
 	FilteredReflogCommits []*models.Commit
@@ -302,6 +309,25 @@ type Model struct {
 	FilesTrie *patricia.Trie
 
 	Authors map[string]*models.Author
+
+	// The undo journal: snapshots of the files taken before destructive
+	// file-level actions, oldest first. Entries move to UndoneJournal when they
+	// are undone, and back again when they are redone.
+	Journal       []*models.JournalEntry
+	UndoneJournal []*models.JournalEntry
+
+	// High-level actions that changed branches, newest first; see
+	// OperationHistoryHelper
+	OperationHistory []*models.Operation
+
+	// Open pull requests of the local branches, keyed by branch name. Only
+	// loaded if git.pullRequests.showInBranchesPanel is enabled.
+	PullRequests map[string]*models.PullRequest
+
+	// Names of the tags that the RemoteForTags remote has; nil until we've
+	// asked it
+	RemoteTagNames map[string]bool
+	RemoteForTags  string
 }
 
 // if you add a new mutex here be sure to instantiate it. We're using pointers to
@@ -22,6 +22,10 @@ const (
 	COMMIT_FILES
 	// not actually a view. Will refactor this later
 	BISECT_INFO
+	// Whether the worktree is sparse, and which directories it contains. Only
+	// changes through sparse-checkout operations, so it's not refreshed along
+	// with the files.
+	SPARSE_CHECKOUT
 )
 
 type RefreshMode int
@@ -72,6 +72,10 @@ func NewRenderStringWithScrollTask(str string, originX int, originY int) *Render
 type RunCommandTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// If set, the command's output is read in full and passed through this
+	// function before being rendered, rather than being streamed line by line.
+	// Only use this for commands with small output.
+	FormatOutput func(output string) string
 }
 
 func (t *RunCommandTask) IsUpdateTask() {}
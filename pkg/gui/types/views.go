@@ -22,6 +22,7 @@ type Views struct {
 	PatchBuilding          *gocui.View
 	PatchBuildingSecondary *gocui.View
 	MergeConflicts         *gocui.View
+	Blame                  *gocui.View
 
 	Options           *gocui.View
 	Confirmation      *gocui.View
@@ -30,6 +31,10 @@ type Views struct {
 	CommitDescription *gocui.View
 	CommitFiles       *gocui.View
 	SubCommits        *gocui.View
+	RangeDiff         *gocui.View
+	OperationHistory  *gocui.View
+	BranchReflog      *gocui.View
+	CustomList        *gocui.View
 	Information       *gocui.View
 	AppStatus         *gocui.View
 	Search            *gocui.View
@@ -36,6 +36,10 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Commits, name: "commits"},
 		{viewPtr: &gui.Views.Stash, name: "stash"},
 		{viewPtr: &gui.Views.SubCommits, name: "subCommits"},
+		{viewPtr: &gui.Views.RangeDiff, name: "rangeDiff"},
+		{viewPtr: &gui.Views.OperationHistory, name: "operationHistory"},
+		{viewPtr: &gui.Views.BranchReflog, name: "branchReflog"},
+		{viewPtr: &gui.Views.CustomList, name: "customList"},
 		{viewPtr: &gui.Views.CommitFiles, name: "commitFiles"},
 
 		{viewPtr: &gui.Views.Staging, name: "staging"},
@@ -43,6 +47,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.PatchBuilding, name: "patchBuilding"},
 		{viewPtr: &gui.Views.PatchBuildingSecondary, name: "patchBuildingSecondary"},
 		{viewPtr: &gui.Views.MergeConflicts, name: "mergeConflicts"},
+		{viewPtr: &gui.Views.Blame, name: "blame"},
 		{viewPtr: &gui.Views.Secondary, name: "secondary"},
 		{viewPtr: &gui.Views.Main, name: "main"},
 
@@ -103,6 +108,12 @@ func (gui *Gui) createAllViews() error {
 
 	gui.Views.CommitFiles.Title = gui.c.Tr.CommitFiles
 
+	gui.Views.RangeDiff.Title = gui.c.Tr.RangeDiffTitle
+
+	gui.Views.OperationHistory.Title = gui.c.Tr.OperationHistoryTitle
+
+	gui.Views.CustomList.Title = gui.c.Tr.CustomListTitle
+
 	gui.Views.Branches.Title = gui.c.Tr.BranchesTitle
 
 	gui.Views.Remotes.Title = gui.c.Tr.RemotesTitle
@@ -136,6 +147,9 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.MergeConflicts.Title = gui.c.Tr.MergeConflictsTitle
 	gui.Views.MergeConflicts.Wrap = false
 
+	gui.Views.Blame.Title = gui.c.Tr.BlameTitle
+	gui.Views.Blame.Wrap = false
+
 	gui.Views.Limit.Title = gui.c.Tr.NotEnoughSpace
 	gui.Views.Limit.Wrap = true
 
@@ -845,6 +845,240 @@ type TranslationSet struct {
 	CommandDoesNotSupportOpeningInEditor     string
 	CustomCommands                           string
 	NoApplicableCommandsInThisContext        string
+	BlameTitle                               string
+	BlameDynamicTitle                        string
+	BlameDynamicTitleAtCommit                string
+	LoadingBlame                             string
+	NotCommittedYet                          string
+	OpenBlame                                string
+	OpenBlameTooltip                         string
+	ExitBlame                                string
+	BlameGoToCommit                          string
+	BlameGoToCommitTooltip                   string
+	BlameParentCommit                        string
+	BlameParentCommitTooltip                 string
+	LineNotCommittedYet                      string
+	LineHasNoEarlierHistory                  string
+	ErrCannotBlameDirectory                  string
+	ErrCannotBlameUntrackedFile              string
+	ErrCannotBlameDeletedFile                string
+	StashSelection                           string
+	StashSelectionTooltip                    string
+	ApplyFilesToWorkingTree                  string
+	ApplyFilesToWorkingTreeTooltip           string
+	RangeDiffTitle                           string
+	RangeDiffDynamicTitle                    string
+	RangeDiffMainTitle                       string
+	LoadingRangeDiff                         string
+	RangeDiffIsEmpty                         string
+	RangeDiffPatchesAreIdentical             string
+	RangeDiffCommitOnlyInOldRange            string
+	RangeDiffCommitOnlyInNewRange            string
+	ShowRangeDiff                            string
+	ShowRangeDiffTooltip                     string
+	EnterRangesForRangeDiff                  string
+	EnterRangesForRangeDiffTooltip           string
+	EnterOldRange                            string
+	EnterNewRange                            string
+	RangeDiffSymmetricRangeMustBeAlone       string
+	ViewNotesOptions                         string
+	ViewNotesOptionsTooltip                  string
+	NotesMenuTitle                           string
+	EditNote                                 string
+	EditNoteInEditor                         string
+	EditNoteTitle                            string
+	RemoveNote                               string
+	CommitHasNoNote                          string
+	FetchNotes                               string
+	FetchNotesTitle                          string
+	PushNotes                                string
+	PushNotesTitle                           string
+	LfsObjectChanged                         string
+	LfsObjectAdded                           string
+	LfsObjectRemoved                         string
+	ViewLfsOptions                           string
+	ViewLfsOptionsTooltip                    string
+	LfsMenuTitle                             string
+	LfsLockSelectedFile                      string
+	LfsUnlockSelectedFile                    string
+	LfsLockFileByPath                        string
+	LfsUnlockFileByPath                      string
+	LfsLockFileByPathTitle                   string
+	LfsUnlockFileByPathTitle                 string
+	SelectedItemIsNotLfsFile                 string
+	LfsFileAlreadyLocked                     string
+	LfsFileNotLocked                         string
+	LfsLockingStatus                         string
+	LfsUnlockingStatus                       string
+	SparseCheckoutStatus                     string
+	ViewSparseCheckoutOptions                string
+	ViewSparseCheckoutOptionsTooltip         string
+	SparseCheckoutMenuTitle                  string
+	SparseCheckoutDirectories                string
+	SparseCheckoutActions                    string
+	SparseCheckoutNotInConeMode              string
+	EnableSparseCheckout                     string
+	EnableSparseCheckoutTitle                string
+	AddSparseCheckoutDirectory               string
+	AddSparseCheckoutDirectoryTitle          string
+	RemoveSparseCheckoutDirectory            string
+	RemoveSparseCheckoutDirectoryTooltip     string
+	RemoveSparseCheckoutDirectoryPrompt      string
+	ReapplySparseCheckout                    string
+	ReapplySparseCheckoutTooltip             string
+	DisableSparseCheckout                    string
+	DisableSparseCheckoutPrompt              string
+	UpdatingSparseCheckoutStatus             string
+	InsertExecTodo                           string
+	InsertExecTodoTooltip                    string
+	InsertExecTodoTitle                      string
+	ExecCommandCannotBeEmpty                 string
+	LabelHere                                string
+	ResetToLabelHere                         string
+	MergeLabelHere                           string
+	ConfirmSelection                         string
+	CustomCommandRequiresRangeSelect         string
+	CustomListTitle                          string
+	CustomListMainTitle                      string
+	ThreeWayConflictTitle                    string
+	ThreeWayOurs                             string
+	ThreeWayBase                             string
+	ThreeWayTheirs                           string
+	ThreeWayResult                           string
+	ThreeWayNoBase                           string
+	ThreeWayEmptyResult                      string
+	ToggleThreeWayView                       string
+	ToggleThreeWayViewTooltip                string
+	ThreeWayNextPane                         string
+	ThreeWayAddLine                          string
+	ThreeWayEditLine                         string
+	ThreeWayApplyResult                      string
+	ThreeWayApplyResultTooltip               string
+	OnlyAvailableInThreeWayView              string
+	NoResultLineSelected                     string
+	PickHunkTooltip                          string
+	PickAllHunksTooltip                      string
+	OpenMergeOptions                         string
+	OpenMergeOptionsTooltip                  string
+	MergeConflictOptionsTitle                string
+	UseOursVersion                           string
+	UseOursVersionTooltip                    string
+	UseTheirsVersion                         string
+	UseTheirsVersionTooltip                  string
+	UseBaseVersion                           string
+	UseBaseVersionTooltip                    string
+	ForgetRerereResolution                   string
+	ForgetRerereResolutionTooltip            string
+	NoFilesWithMergeConflicts                string
+	NoSelectedFilesWithMergeConflicts        string
+	NoSelectedFilesResolvedByRerere          string
+	BranchReflogTitle                        string
+	BranchReflogDynamicTitle                 string
+	ViewBranchReflog                         string
+	ViewBranchReflogTooltip                  string
+	RestoreBranchToReflogEntry               string
+	RestoreBranchToReflogEntryTooltip        string
+	RestoreBranchTitle                       string
+	RestoreBranchPrompt                      string
+	RestoreCheckedOutBranchPrompt            string
+	CannotRestoreRemoteBranch                string
+	AlreadyAtReflogEntry                     string
+	ShowingDiffBetweenReflogEntries          string
+	UndoJournalEntryPrompt                   string
+	RedoJournalEntryPrompt                   string
+	CantUndoJournalEntryHeadMoved            string
+	CantUndoWithoutSnapshot                  string
+	ViewOperationHistory                     string
+	ViewOperationHistoryTooltip              string
+	OperationHistoryTitle                    string
+	NoOperationsToRollBack                   string
+	RollBackOperationTitle                   string
+	RollBackOperationPrompt                  string
+	RollingBackStatus                        string
+	CantRollBackWhileRebasing                string
+	CantRollBackHeadChanged                  string
+	CantRollBackBranchChanged                string
+	OperationCheckedOut                      string
+	OperationBranchMoved                     string
+	OperationBranchCreated                   string
+	OperationBranchDeleted                   string
+	RollBackOperation                        string
+	RollBackOperationTooltip                 string
+	OperationMainTitle                       string
+	LoadingOperationHistory                  string
+	WorktreeChangedFiles                     string
+	WorktreeConflictedFiles                  string
+	WorktreeDetached                         string
+	WorktreeChanges                          string
+	WorktreeUpstream                         string
+	WorktreeState                            string
+	WorktreeClean                            string
+	WorktreeNoUpstream                       string
+	WorktreeInSync                           string
+	WorktreeAheadBehind                      string
+	WorktreeStatusLoading                    string
+	UnsupportedGitServiceAPI                 string
+	GitServiceAPITokenRequired               string
+	PullRequestDraft                         string
+	CreatePullRequestInLazygit               string
+	CreatePullRequestInLazygitTooltip        string
+	PreparingPullRequestStatus               string
+	CreatingPullRequestStatus                string
+	PullRequestBaseBranchTitle               string
+	PullRequestTitleTitle                    string
+	PullRequestDescriptionTitle              string
+	PullRequestTitleOption                   string
+	PullRequestReviewersOption               string
+	PullRequestLabelsOption                  string
+	PullRequestDraftOption                   string
+	PullRequestReviewersPrompt               string
+	PullRequestLabelsPrompt                  string
+	PullRequestOptionNone                    string
+	PullRequestOptionYes                     string
+	PullRequestOptionNo                      string
+	PullRequestCreated                       string
+	UnsupportedPullRequestCheckout           string
+	CheckoutPullRequest                      string
+	CheckoutPullRequestTooltip               string
+	LoadingPullRequestsStatus                string
+	FetchingPullRequestStatus                string
+	EnterPullRequestNumber                   string
+	PullRequestNumberPrompt                  string
+	InvalidPullRequestNumber                 string
+	PullRequestBranchNamePrompt              string
+	DeleteTagsTitle                          string
+	DeleteLocalTags                          string
+	DeleteRemoteTags                         string
+	DeleteLocalAndRemoteTags                 string
+	RemoteTagsDeletedMessage                 string
+	SelectRemoteTagsUpstream                 string
+	DeleteRemoteTagsPrompt                   string
+	DeleteLocalAndRemoteTagsPrompt           string
+	PushTagsTitle                            string
+	PushingTagsStatus                        string
+	VerifyTag                                string
+	VerifyTagTooltip                         string
+	VerifyingTagStatus                       string
+	VerifyTagTitle                           string
+	CantVerifyLightweightTag                 string
+	TagTagger                                string
+	TagDate                                  string
+	TagSignature                             string
+	TagSigned                                string
+	TagNotSigned                             string
+	TagLightweight                           string
+	TagRemote                                string
+	TagOnRemote                              string
+	TagNotOnRemote                           string
+	CreateReleaseTag                         string
+	CreateReleaseTagTooltip                  string
+	NextPatchVersion                         string
+	NextMinorVersion                         string
+	NextMajorVersion                         string
+	NextPreReleaseVersion                    string
+	CommitsSinceReleaseTag                   string
+	NoCommitsSinceReleaseTag                 string
+	NoReleaseTags                            string
 	Actions                                  Actions
 	Bisect                                   Bisect
 	Log                                      Log
@@ -934,12 +1168,33 @@ type Actions struct {
 	DiscardAllChangesInFile           string
 	DiscardAllUnstagedChangesInFile   string
 	StageFile                         string
+	CheckoutPullRequest               string
+	VerifyTag                         string
 	StageResolvedFiles                string
+	CreatePullRequest                 string
+	DiscardSelectedLines              string
+	RollBackOperation                 string
+	RestoreBranch                     string
+	ResolveConflictWithVersion        string
+	ForgetRerereResolution            string
 	UnstageFile                       string
 	UnstageAllFiles                   string
 	StageAllFiles                     string
 	NotEnoughContextToStage           string
 	NotEnoughContextToDiscard         string
+	NotEnoughContextToStash           string
+	EditNote                          string
+	RemoveNote                        string
+	FetchNotes                        string
+	PushNotes                         string
+	LfsLockFile                       string
+	LfsUnlockFile                     string
+	InsertExecTodo                    string
+	EnableSparseCheckout              string
+	AddSparseCheckoutDirectory        string
+	RemoveSparseCheckoutDirectory     string
+	ReapplySparseCheckout             string
+	DisableSparseCheckout             string
 	IgnoreExcludeFile                 string
 	IgnoreFileErr                     string
 	ExcludeFile                       string
@@ -954,6 +1209,8 @@ type Actions struct {
 	StashStagedChanges                string
 	StashUnstagedChanges              string
 	StashIncludeUntrackedChanges      string
+	ApplyFilesToWorkingTree           string
+	StashSelection                    string
 	GitFlowFinish                     string
 	GitFlowStart                      string
 	CopyToClipboard                   string
@@ -1224,8 +1481,8 @@ func EnglishTranslationSet() *TranslationSet {
 		Undo:                                 "Undo",
 		UndoReflog:                           "Undo",
 		RedoReflog:                           "Redo",
-		UndoTooltip:                          "The reflog will be used to determine what git command to run to undo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
-		RedoTooltip:                          "The reflog will be used to determine what git command to run to redo the last git command. This does not include changes to the working tree; only commits are taken into consideration.",
+		UndoTooltip:                          "The reflog will be used to determine what git command to run to undo the last git command. Destructive changes to the working tree (like discarding files, lines or hunks) are undone too, using snapshots taken before they were made.",
+		RedoTooltip:                          "The reflog will be used to determine what git command to run to redo the last git command. Destructive changes to the working tree (like discarding files, lines or hunks) are redone too, using snapshots taken before they were made.",
 		UndoMergeResolveTooltip:              "Undo last merge conflict resolution.",
 		DiscardAllTooltip:                    "Discard both staged and unstaged changes in '{{.path}}'.",
 		DiscardUnstagedTooltip:               "Discard unstaged changes in '{{.path}}'.",
@@ -1461,7 +1718,7 @@ func EnglishTranslationSet() *TranslationSet {
 		DiscardStagedChanges:                 "Discard staged changes",
 		HardReset:                            "Hard reset",
 		BranchDeleteTooltip:                  "View delete options for local/remote branch.",
-		TagDeleteTooltip:                     "View delete options for local/remote tag.",
+		TagDeleteTooltip:                     "View delete options for the selected local/remote tag(s).",
 		Delete:                               "Delete",
 		Reset:                                "Reset",
 		ResetTooltip:                         "View reset options (soft/mixed/hard) for resetting onto selected item.",
@@ -1577,7 +1834,7 @@ func EnglishTranslationSet() *TranslationSet {
 		PushTagTitle:                         "Remote to push tag '{{.tagName}}' to:",
 		// Using 'push tag' rather than just 'push' to disambiguate from a global push
 		PushTag:                        "Push tag",
-		PushTagTooltip:                 "Push the selected tag to a remote. You'll be prompted to select a remote.",
+		PushTagTooltip:                 "Push the selected tag(s) to a remote. You'll be prompted to select a remote.",
 		NewTag:                         "New tag",
 		NewTagTooltip:                  "Create new tag from current commit. You'll be prompted to enter a tag name and optional description.",
 		CreatingTag:                    "Creating tag",
@@ -1884,6 +2141,240 @@ func EnglishTranslationSet() *TranslationSet {
 		CustomCommands:                           "Custom commands",
 		NoApplicableCommandsInThisContext:        "(No applicable commands in this context)",
 
+		BlameTitle:                           "Blame",
+		BlameDynamicTitle:                    "Blame: %s",
+		BlameDynamicTitleAtCommit:            "Blame: %s @ %s",
+		LoadingBlame:                         "Loading blame",
+		NotCommittedYet:                      "Not committed yet",
+		OpenBlame:                            "Blame file",
+		OpenBlameTooltip:                     "Show the commit that last changed each line of the selected file, with lines grouped by commit.",
+		ExitBlame:                            "Exit blame",
+		BlameGoToCommit:                      "Go to commit",
+		BlameGoToCommitTooltip:               "Select the commit that last changed the selected line in the commits panel.",
+		BlameParentCommit:                    "Blame parent commit",
+		BlameParentCommitTooltip:             "Blame the file as of the parent of the commit that last changed the selected line, to see how the line looked before that commit.",
+		LineNotCommittedYet:                  "The selected line has not been committed yet",
+		LineHasNoEarlierHistory:              "The selected line was added in the first commit of the file's history",
+		ErrCannotBlameDirectory:              "Cannot blame directories: you can only blame individual files",
+		ErrCannotBlameUntrackedFile:          "Cannot blame a file that is not tracked by git",
+		ErrCannotBlameDeletedFile:            "Cannot blame a deleted file",
+		StashSelection:                       "Stash selection",
+		StashSelectionTooltip:                "Stash the selected lines or hunk and remove them from the working tree. If the changes are staged, they are stashed as staged changes so that they can be restored to the index again.",
+		ApplyFilesToWorkingTree:              "Apply to working tree",
+		ApplyFilesToWorkingTreeTooltip:       "Apply the changes of the selected files to the working tree, e.g. to restore only some of the files of a stash entry. To apply only some of the hunks of a file, add them to a custom patch and apply that from the patch options menu instead.",
+		RangeDiffTitle:                       "Range-diff",
+		RangeDiffDynamicTitle:                "Range-diff: %s vs %s",
+		RangeDiffMainTitle:                   "Interdiff",
+		LoadingRangeDiff:                     "Loading range-diff...",
+		RangeDiffIsEmpty:                     "There are no commits to compare",
+		RangeDiffPatchesAreIdentical:         "The patches of both commits are identical.",
+		RangeDiffCommitOnlyInOldRange:        "This commit only exists in the old version.",
+		RangeDiffCommitOnlyInNewRange:        "This commit only exists in the new version.",
+		ShowRangeDiff:                        "Show range-diff of %s and %s",
+		ShowRangeDiffTooltip:                 "Compare the commits of two versions of a branch (e.g. before and after rebasing it) using `git range-diff`. Each commit of the old version is paired up with the corresponding commit of the new version, and the main view shows how their patches differ.",
+		EnterRangesForRangeDiff:              "Enter ranges for range-diff",
+		EnterRangesForRangeDiffTooltip:       "Enter the old and new version of a series of commits to compare them using `git range-diff`. Each can be either a range (e.g. main..topic@{1}) or a single ref, in which case the merge base of the two refs is used as the base of both. Alternatively, enter a symmetric range (e.g. topic@{1}...topic) for one of them and leave the other one empty.",
+		EnterOldRange:                        "Old version (ref or range):",
+		EnterNewRange:                        "New version (ref or range):",
+		RangeDiffSymmetricRangeMustBeAlone:   "A symmetric range (e.g. topic@{1}...topic) already contains both versions, so it can't be combined with another ref or range. Leave the other version empty.",
+		ViewNotesOptions:                     "View notes options",
+		ViewNotesOptionsTooltip:              "View options for git notes attached to the selected commit: add, edit, or remove the note, or fetch and push notes refs.",
+		NotesMenuTitle:                       "Notes",
+		EditNote:                             "Add/edit note",
+		EditNoteInEditor:                     "Add/edit note in external editor",
+		EditNoteTitle:                        "Note (leave empty to remove)",
+		RemoveNote:                           "Remove note",
+		CommitHasNoNote:                      "Commit has no note",
+		FetchNotes:                           "Fetch notes from remote",
+		FetchNotesTitle:                      "Fetch notes from remote",
+		PushNotes:                            "Push notes to remote",
+		PushNotesTitle:                       "Push notes to remote",
+		LfsObjectChanged:                     "LFS object changed ({{oldSize}} → {{newSize}})",
+		LfsObjectAdded:                       "LFS object added ({{size}})",
+		LfsObjectRemoved:                     "LFS object removed ({{size}})",
+		ViewLfsOptions:                       "View Git LFS options",
+		ViewLfsOptionsTooltip:                "View options for files tracked by Git LFS, such as locking and unlocking them. Only locks that you hold yourself are shown in the files panel.",
+		LfsMenuTitle:                         "Git LFS",
+		LfsLockSelectedFile:                  "Lock selected file",
+		LfsUnlockSelectedFile:                "Unlock selected file",
+		LfsLockFileByPath:                    "Lock file by path",
+		LfsUnlockFileByPath:                  "Unlock file by path",
+		LfsLockFileByPathTitle:               "Path of file to lock",
+		LfsUnlockFileByPathTitle:             "Path of file to unlock",
+		SelectedItemIsNotLfsFile:             "The selected item is not a file tracked by Git LFS",
+		LfsFileAlreadyLocked:                 "You already hold a lock on this file",
+		LfsFileNotLocked:                     "You don't hold a lock on this file",
+		LfsLockingStatus:                     "Locking",
+		LfsUnlockingStatus:                   "Unlocking",
+		SparseCheckoutStatus:                 "sparse",
+		ViewSparseCheckoutOptions:            "View sparse-checkout options",
+		ViewSparseCheckoutOptionsTooltip:     "View options for the sparse-checkout of the current worktree, e.g. adding or removing directories, or enabling/disabling sparse-checkout.",
+		SparseCheckoutMenuTitle:              "Sparse-checkout",
+		SparseCheckoutDirectories:            "Directories",
+		SparseCheckoutActions:                "Actions",
+		SparseCheckoutNotInConeMode:          "Only available for sparse-checkouts in cone mode.",
+		EnableSparseCheckout:                 "Enable sparse-checkout",
+		EnableSparseCheckoutTitle:            "Directories to check out (separated by spaces):",
+		AddSparseCheckoutDirectory:           "Add directory",
+		AddSparseCheckoutDirectoryTitle:      "Directories to add (separated by spaces):",
+		RemoveSparseCheckoutDirectory:        "Remove directory",
+		RemoveSparseCheckoutDirectoryTooltip: "Remove this directory from the sparse-checkout. Its files will be removed from the working tree.",
+		RemoveSparseCheckoutDirectoryPrompt:  "Are you sure you want to remove '%s' from the sparse-checkout? Its files will be removed from the working tree.",
+		ReapplySparseCheckout:                "Reapply",
+		ReapplySparseCheckoutTooltip:         "Update the working tree to match the sparse-checkout directories again, e.g. after a merge or checkout brought in files from outside of them.",
+		DisableSparseCheckout:                "Disable sparse-checkout",
+		DisableSparseCheckoutPrompt:          "Are you sure you want to disable sparse-checkout? All files will be checked out into the working tree.",
+		UpdatingSparseCheckoutStatus:         "Updating sparse-checkout",
+		InsertExecTodo:                       "Insert exec todo",
+		InsertExecTodoTooltip:                "Insert an exec todo after each of the selected commits, running the given shell command at that point of the rebase. If you are not in a rebase, this starts one.",
+		InsertExecTodoTitle:                  "Command to execute:",
+		ExecCommandCannotBeEmpty:             "Command cannot be empty",
+		LabelHere:                            "Label the current commit as '{{.label}}'",
+		ResetToLabelHere:                     "Reset HEAD to the commit labeled '{{.label}}'",
+		MergeLabelHere:                       "Merge the commit labeled '{{.label}}'",
+		ConfirmSelection:                     "Confirm selection",
+		CustomCommandRequiresRangeSelect:     "This command requires selecting a range of items",
+		CustomListTitle:                      "Command output",
+		CustomListMainTitle:                  "Line",
+		ThreeWayConflictTitle:                "Conflict {{index}} of {{count}}",
+		ThreeWayOurs:                         "Ours",
+		ThreeWayBase:                         "Base",
+		ThreeWayTheirs:                       "Theirs",
+		ThreeWayResult:                       "Result",
+		ThreeWayNoBase:                       "(no base: set merge.conflictStyle to diff3 to see it)",
+		ThreeWayEmptyResult:                  "Pick lines from the panes above to build the result",
+		ToggleThreeWayView:                   "Toggle three-way view",
+		ToggleThreeWayViewTooltip:            "Switch between showing the conflicts inline in the file, and showing the selected conflict as three panes (ours, base and theirs) side by side with the result below. In the three-way view, press {{selectKey}} to add the selected line to the result (or remove it again), {{pickAllKey}} to add all lines of the selected pane, {{togglePanelKey}} to switch between panes, {{newKey}} to type in a new line, {{editKey}} to edit the selected line of the result, and {{confirmKey}} to replace the conflict with the result.",
+		ThreeWayNextPane:                     "Next pane",
+		ThreeWayAddLine:                      "Add line to result",
+		ThreeWayEditLine:                     "Edit result line",
+		ThreeWayApplyResult:                  "Apply result",
+		ThreeWayApplyResultTooltip:           "Replace the selected conflict with the lines of the result pane.",
+		OnlyAvailableInThreeWayView:          "Only available in the three-way view",
+		NoResultLineSelected:                 "Select a line of the result pane first",
+		PickHunkTooltip:                      "Resolve the selected conflict by keeping the selected hunk. In the three-way view, add the selected line to the result, or remove it again.",
+		PickAllHunksTooltip:                  "Resolve the selected conflict by keeping all hunks. In the three-way view, add all lines of the selected pane to the result.",
+		OpenMergeOptions:                     "View merge conflict options",
+		OpenMergeOptionsTooltip:              "View options for resolving merge conflicts, e.g. resolving whole files with our or their version, or opening `git mergetool`.",
+		MergeConflictOptionsTitle:            "Merge conflict options",
+		UseOursVersion:                       "Use ours",
+		UseOursVersionTooltip:                "Resolve the selected files by using our version (the one from the branch you are merging into or rebasing onto). If our side deleted the file, it will be deleted.",
+		UseTheirsVersion:                     "Use theirs",
+		UseTheirsVersionTooltip:              "Resolve the selected files by using their version (the one from the branch being merged or the commit being applied). If their side deleted the file, it will be deleted.",
+		UseBaseVersion:                       "Use base",
+		UseBaseVersionTooltip:                "Resolve the selected files by using the version of their common ancestor, discarding the changes of both sides. If the file didn't exist in the common ancestor, it will be deleted.",
+		ForgetRerereResolution:               "Forget recorded resolution",
+		ForgetRerereResolutionTooltip:        "Tell rerere to forget the resolution it recorded for the selected files, and restore their conflict markers so that you can resolve them again.",
+		NoFilesWithMergeConflicts:            "No files with merge conflicts",
+		NoSelectedFilesWithMergeConflicts:    "None of the selected files have merge conflicts",
+		NoSelectedFilesResolvedByRerere:      "None of the selected files were resolved by rerere",
+		BranchReflogTitle:                    "Branch reflog",
+		BranchReflogDynamicTitle:             "Reflog (%s)",
+		ViewBranchReflog:                     "View reflog",
+		ViewBranchReflogTooltip:              "View the reflog of the selected branch, i.e. every position it has pointed at, e.g. before a rebase or a force-push. Select a range of entries to see the diff between them.",
+		RestoreBranchToReflogEntry:           "Restore branch",
+		RestoreBranchToReflogEntryTooltip:    "Move the branch back to the selected reflog entry. The position it is moved away from is recorded in its reflog too, so you can always restore it again from here.",
+		RestoreBranchTitle:                   "Restore branch",
+		RestoreBranchPrompt:                  "Are you sure you want to move branch '{{branch}}' from {{currentHash}} to {{hash}} ({{entry}})? Its current position will stay in its reflog.",
+		RestoreCheckedOutBranchPrompt:        "Are you sure you want to reset the checked-out branch '{{branch}}' from {{currentHash}} to {{hash}} ({{entry}})? Uncommitted changes are kept, and you can undo this with '{{undoKey}}'.",
+		CannotRestoreRemoteBranch:            "Remote branches can't be restored, because they mirror the state of the remote. Create a local branch from the reflog entry instead.",
+		AlreadyAtReflogEntry:                 "The branch already points at this entry",
+		ShowingDiffBetweenReflogEntries:      "Showing diff between reflog entries",
+		UndoJournalEntryPrompt:               "Are you sure you want to undo '%s'? Your files will be restored to how they were before it, and their current state will be kept so that you can redo.",
+		RedoJournalEntryPrompt:               "Are you sure you want to redo '%s'?",
+		CantUndoJournalEntryHeadMoved:        "Can't restore files from before '{{action}}' because HEAD has moved since.",
+		CantUndoWithoutSnapshot:              "Couldn't save the current state of your files, so '{{.action}}' can't be undone",
+		ViewOperationHistory:                 "View operation history",
+		ViewOperationHistoryTooltip:          "Show the actions performed in this session that changed branches, along with how they changed them. Any of them can be rolled back, as long as the branches it touched haven't changed since.",
+		OperationHistoryTitle:                "Operation history",
+		NoOperationsToRollBack:               "No actions that changed any branches have been performed yet.",
+		RollBackOperationTitle:               "Roll back",
+		RollBackOperationPrompt:              "Are you sure you want to roll back '{{action}}'? This will undo the following changes:\n\n{{changes}}",
+		RollingBackStatus:                    "Rolling back",
+		CantRollBackWhileRebasing:            "Can't roll back while rebasing or merging.",
+		CantRollBackHeadChanged:              "Can't roll back because a different branch has been checked out since.",
+		CantRollBackBranchChanged:            "Can't roll back because branch '{{branch}}' has changed since.",
+		OperationCheckedOut:                  "Checked out {{to}} (was {{from}})",
+		OperationBranchMoved:                 "{{branch}}: {{from}} → {{to}}",
+		OperationBranchCreated:               "{{branch}}: created at {{to}}",
+		OperationBranchDeleted:               "{{branch}}: deleted (was at {{from}})",
+		RollBackOperation:                    "Roll back",
+		RollBackOperationTooltip:             "Put the branches that the selected action changed back where they were before it. Only possible if they haven't changed again since.",
+		OperationMainTitle:                   "Operation",
+		LoadingOperationHistory:              "Loading operation history...",
+		WorktreeChangedFiles:                 "{{count}} changed",
+		WorktreeConflictedFiles:              "{{count}} conflicted",
+		WorktreeDetached:                     "(detached)",
+		WorktreeChanges:                      "Changes",
+		WorktreeUpstream:                     "Upstream",
+		WorktreeState:                        "State",
+		WorktreeClean:                        "clean",
+		WorktreeNoUpstream:                   "none",
+		WorktreeInSync:                       "up to date",
+		WorktreeAheadBehind:                  "{{ahead}} ahead, {{behind}} behind",
+		WorktreeStatusLoading:                "loading...",
+		UnsupportedGitServiceAPI:             "Talking to the API of this git service is not supported",
+		GitServiceAPITokenRequired:           "Talking to the API of {{.domain}} requires a token. Add one to git.pullRequests.tokens in your config, or log in with the service's command line tool",
+		PullRequestDraft:                     "draft",
+		CreatePullRequestInLazygit:           "Create pull request in lazygit",
+		CreatePullRequestInLazygitTooltip:    "Create a pull request for the selected branch through the API of the hosting service, without going through the browser. The branch is pushed first if it has commits that aren't on its remote yet.",
+		PreparingPullRequestStatus:           "Preparing pull request",
+		CreatingPullRequestStatus:            "Creating pull request",
+		PullRequestBaseBranchTitle:           "Base branch for {{branch}}",
+		PullRequestTitleTitle:                "Pull request title",
+		PullRequestDescriptionTitle:          "Pull request description",
+		PullRequestTitleOption:               "Title",
+		PullRequestReviewersOption:           "Reviewers",
+		PullRequestLabelsOption:              "Labels",
+		PullRequestDraftOption:               "Draft",
+		PullRequestReviewersPrompt:           "Reviewers (comma-separated)",
+		PullRequestLabelsPrompt:              "Labels (comma-separated)",
+		PullRequestOptionNone:                "none",
+		PullRequestOptionYes:                 "yes",
+		PullRequestOptionNo:                  "no",
+		PullRequestCreated:                   "Created pull request #{{number}}",
+		UnsupportedPullRequestCheckout:       "Checking out pull requests by number is not supported for this git service",
+		CheckoutPullRequest:                  "Check out pull request",
+		CheckoutPullRequestTooltip:           "Fetch a pull request (a merge request on GitLab) from the selected remote into a local branch and check it out. Pick one of the open pull requests, or enter its number. The branch tracks the pull request, so that `git pull` updates it; doing this again for a branch that isn't checked out fast-forwards it.",
+		LoadingPullRequestsStatus:            "Loading pull requests",
+		FetchingPullRequestStatus:            "Fetching pull request",
+		EnterPullRequestNumber:               "Enter pull request number",
+		PullRequestNumberPrompt:              "Pull request number",
+		InvalidPullRequestNumber:             "'{{number}}' is not a valid pull request number",
+		PullRequestBranchNamePrompt:          "Local branch for pull request #{{number}}",
+		DeleteTagsTitle:                      "Delete selected tags?",
+		DeleteLocalTags:                      "Delete local tags",
+		DeleteRemoteTags:                     "Delete remote tags",
+		DeleteLocalAndRemoteTags:             "Delete local and remote tags",
+		RemoteTagsDeletedMessage:             "Remote tags deleted",
+		SelectRemoteTagsUpstream:             "Remote from which to remove the selected tags:",
+		DeleteRemoteTagsPrompt:               "Are you sure you want to delete the selected tags from '{{.upstream}}'?",
+		DeleteLocalAndRemoteTagsPrompt:       "Are you sure you want to delete the selected tags from both your machine and from '{{.upstream}}'?",
+		PushTagsTitle:                        "Remote to push the selected tags to:",
+		PushingTagsStatus:                    "Pushing tags",
+		VerifyTag:                            "Verify tag",
+		VerifyTagTooltip:                     "Check the signature of the selected annotated tag, using gpg or ssh depending on your gpg.format config.",
+		VerifyingTagStatus:                   "Verifying tag",
+		VerifyTagTitle:                       "Signature of tag '{{.tagName}}'",
+		CantVerifyLightweightTag:             "Lightweight tags can't be signed",
+		TagTagger:                            "Tagger",
+		TagDate:                              "Date",
+		TagSignature:                         "Signature",
+		TagSigned:                            "Signed",
+		TagNotSigned:                         "Not signed",
+		TagLightweight:                       "Lightweight tag",
+		TagRemote:                            "Remote",
+		TagOnRemote:                          "On {{.remote}}",
+		TagNotOnRemote:                       "Not on {{.remote}}",
+		CreateReleaseTag:                     "Create release tag",
+		CreateReleaseTagTooltip:              "Create an annotated tag for the next semantic version after the latest release tag, with a message listing the commits since that tag. Which tags count as release tags is determined by the git.releaseTagPrefix config.",
+		NextPatchVersion:                     "Patch",
+		NextMinorVersion:                     "Minor",
+		NextMajorVersion:                     "Major",
+		NextPreReleaseVersion:                "Pre-release",
+		CommitsSinceReleaseTag:               "Commits since {{.tagName}}:",
+		NoCommitsSinceReleaseTag:             "There are no commits since {{.tagName}}.",
+		NoReleaseTags:                        "There are no tags yet that are semantic versions with prefix {{.prefix}}.",
 		Actions: Actions{
 			// TODO: combine this with the original keybinding descriptions (those are all in lowercase atm)
 			CheckoutCommit:                   "Checkout commit",
@@ -1937,12 +2428,33 @@ func EnglishTranslationSet() *TranslationSet {
 			DiscardAllChangesInFile:         "Discard all changes in selected file(s)",
 			DiscardAllUnstagedChangesInFile: "Discard all unstaged changes selected file(s)",
 			StageFile:                       "Stage file",
+			CheckoutPullRequest:             "Check out pull request",
+			VerifyTag:                       "Verify tag",
 			StageResolvedFiles:              "Stage files whose merge conflicts were resolved",
+			CreatePullRequest:               "Create pull request",
+			DiscardSelectedLines:            "Discard selected lines",
+			RollBackOperation:               "Roll back '{{action}}'",
+			RestoreBranch:                   "Restore branch from reflog",
+			ResolveConflictWithVersion:      "Resolve merge conflicts with version",
+			ForgetRerereResolution:          "Forget rerere resolution",
 			UnstageFile:                     "Unstage file",
 			UnstageAllFiles:                 "Unstage all files",
 			StageAllFiles:                   "Stage all files",
 			NotEnoughContextToStage:         "Staging or unstaging changes is not possible with a diff context size of 0. Increase the context using '%s'.",
 			NotEnoughContextToDiscard:       "Discarding changes is not possible with a diff context size of 0. Increase the context using '%s'.",
+			NotEnoughContextToStash:         "Stashing selected changes is not possible with a diff context size of 0. Increase the context using '%s'.",
+			EditNote:                        "Edit note",
+			RemoveNote:                      "Remove note",
+			FetchNotes:                      "Fetch notes",
+			PushNotes:                       "Push notes",
+			LfsLockFile:                     "Lock LFS file",
+			LfsUnlockFile:                   "Unlock LFS file",
+			InsertExecTodo:                  "Insert exec todo",
+			EnableSparseCheckout:            "Enable sparse-checkout",
+			AddSparseCheckoutDirectory:      "Add sparse-checkout directory",
+			RemoveSparseCheckoutDirectory:   "Remove sparse-checkout directory",
+			ReapplySparseCheckout:           "Reapply sparse-checkout",
+			DisableSparseCheckout:           "Disable sparse-checkout",
 			IgnoreExcludeFile:               "Ignore or exclude file",
 			IgnoreFileErr:                   "Cannot ignore .gitignore",
 			ExcludeFile:                     "Exclude file",
@@ -1957,6 +2469,8 @@ func EnglishTranslationSet() *TranslationSet {
 			StashStagedChanges:              "Stash staged changes",
 			StashUnstagedChanges:            "Stash unstaged changes",
 			StashIncludeUntrackedChanges:    "Stash all changes including untracked files",
+			ApplyFilesToWorkingTree:         "Apply files to working tree",
+			StashSelection:                  "Stash selected changes",
 			GitFlowFinish:                   "git flow finish",
 			GitFlowStart:                    "git flow start",
 			CopyToClipboard:                 "Copy to clipboard",
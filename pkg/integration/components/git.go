@@ -21,12 +21,31 @@ func (self *Git) TagNamesAt(ref string, expectedNames []string) *Git {
 	return self.assert([]string{"git", "tag", "--sort=v:refname", "--points-at", ref}, strings.Join(expectedNames, "\n"))
 }
 
+func (self *Git) LocalBranchRefs(expectedRefs []string) *Git {
+	expected := strings.Join(expectedRefs, "\n")
+	return self.expect([]string{"git", "for-each-ref", "--format=%(refname)", "refs/heads"}, func(s string) (bool, string) {
+		return s == expected, fmt.Sprintf("Expected local branches to be '%s', but got '%s'", expected, s)
+	})
+}
+
 func (self *Git) RemoteTagDeleted(ref string, tagName string) *Git {
 	return self.expect([]string{"git", "ls-remote", ref, fmt.Sprintf("refs/tags/%s", tagName)}, func(s string) (bool, string) {
 		return len(s) == 0, fmt.Sprintf("Expected tag %s to have been removed from %s", tagName, ref)
 	})
 }
 
+func (self *Git) RemoteRefExists(ref string, refName string) *Git {
+	return self.expect([]string{"git", "ls-remote", ref, refName}, func(s string) (bool, string) {
+		return len(s) > 0, fmt.Sprintf("Expected %s to exist on %s", refName, ref)
+	})
+}
+
+func (self *Git) ConfigValue(key string, expectedValue string) *Git {
+	return self.expect([]string{"git", "config", key}, func(s string) (bool, string) {
+		return s == expectedValue, fmt.Sprintf("Expected git config '%s' to be '%s', but got '%s'", key, expectedValue, s)
+	})
+}
+
 func (self *Git) assert(cmdArgs []string, expected string) *Git {
 	self.expect(cmdArgs, func(output string) (bool, string) {
 		return output == expected, fmt.Sprintf("Expected current branch name to be '%s', but got '%s'", expected, output)
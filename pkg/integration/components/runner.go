@@ -206,11 +206,12 @@ func getLazygitCommand(
 
 	cmdArgs := []string{tempLazygitPath(), "-debug", "--use-config-dir=" + paths.Config()}
 
+	placeholders := map[string]string{
+		"actualPath":     paths.Actual(),
+		"actualRepoPath": paths.ActualRepo(),
+	}
 	resolvedExtraArgs := lo.Map(test.ExtraCmdArgs(), func(arg string, _ int) string {
-		return utils.ResolvePlaceholderString(arg, map[string]string{
-			"actualPath":     paths.Actual(),
-			"actualRepoPath": paths.ActualRepo(),
-		})
+		return utils.ResolvePlaceholderString(arg, placeholders)
 	})
 	cmdArgs = append(cmdArgs, resolvedExtraArgs...)
 
@@ -246,7 +247,7 @@ func getLazygitCommand(
 	cmdObj.AddEnvVars(fmt.Sprintf("GORACE=log_path=%s", raceDetectorLogsPath()))
 	if test.ExtraEnvVars() != nil {
 		for key, value := range test.ExtraEnvVars() {
-			cmdObj.AddEnvVars(fmt.Sprintf("%s=%s", key, value))
+			cmdObj.AddEnvVars(fmt.Sprintf("%s=%s", key, utils.ResolvePlaceholderString(value, placeholders)))
 		}
 	}
 
@@ -54,6 +54,8 @@ type NewIntegrationTestArgs struct {
 	Run func(t *TestDriver, keys config.KeybindingConfig)
 	// additional args passed to lazygit
 	ExtraCmdArgs []string
+	// additional environment variables passed to lazygit. Like ExtraCmdArgs,
+	// the values may use the {{actualPath}} and {{actualRepoPath}} placeholders.
 	ExtraEnvVars map[string]string
 	// for when a test is flakey
 	Skip bool
@@ -42,6 +42,10 @@ func (self *Views) MergeConflicts() *ViewDriver {
 	return self.regularView("mergeConflicts")
 }
 
+func (self *Views) Blame() *ViewDriver {
+	return self.regularView("blame")
+}
+
 func (self *Views) Commits() *ViewDriver {
 	return self.regularView("commits")
 }
@@ -94,6 +98,22 @@ func (self *Views) SubCommits() *ViewDriver {
 	return self.regularView("subCommits")
 }
 
+func (self *Views) RangeDiff() *ViewDriver {
+	return self.regularView("rangeDiff")
+}
+
+func (self *Views) OperationHistory() *ViewDriver {
+	return self.regularView("operationHistory")
+}
+
+func (self *Views) CustomList() *ViewDriver {
+	return self.regularView("customList")
+}
+
+func (self *Views) BranchReflog() *ViewDriver {
+	return self.regularView("branchReflog")
+}
+
 func (self *Views) CommitFiles() *ViewDriver {
 	return self.regularView("commitFiles")
 }
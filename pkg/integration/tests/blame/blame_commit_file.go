@@ -0,0 +1,46 @@
+package blame
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BlameCommitFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Blame a file from the commit files panel and return to it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file.txt", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file.txt", "one\ntwo\n")
+		shell.Commit("second commit")
+		shell.UpdateFileAndAdd("file.txt", "one\ntwo\nthree\n")
+		shell.Commit("third commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("second commit")).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file.txt").IsSelected(),
+			).
+			Press(keys.CommitFiles.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Contains("Blame: file.txt @ ")).
+			Lines(
+				Contains("1").Contains("one").IsSelected(),
+				Contains("2").Contains("two"),
+			).
+			PressEscape()
+
+		t.Views().CommitFiles().
+			IsFocused()
+	},
+})
@@ -0,0 +1,58 @@
+package blame
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BlameFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Blame a file from the files panel, blame the parent commit of a line, and jump to the blamed commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file.txt", "one\ntwo\n")
+		shell.Commit("first commit")
+		shell.SetAuthor("Jane Doe", "jane@example.com")
+		shell.UpdateFileAndAdd("file.txt", "one\nTWO\n")
+		shell.Commit("second commit")
+		shell.UpdateFile("file.txt", "one\nTWO\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file.txt").IsSelected(),
+			).
+			Press(keys.Files.OpenBlame)
+
+		t.Views().Blame().
+			IsFocused().
+			Title(Equals("Blame: file.txt")).
+			Lines(
+				Contains("CI").Contains("1").Contains("one").IsSelected(),
+				Contains("Jane Doe").Contains("2").Contains("TWO"),
+				Contains("Not committed yet").Contains("3").Contains("three"),
+			).
+			NavigateToLine(Contains("TWO")).
+			Press(keys.Main.BlameParentCommit).
+			Title(Contains("Blame: file.txt @ ")).
+			Lines(
+				// lines from the same commit only show the commit info once
+				Contains("CI").Contains("1").Contains("one"),
+				DoesNotContain("CI").Contains("2").Contains("two").IsSelected(),
+			).
+			Press(keys.Main.BlameParentCommit).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: The selected line was added in the first commit of the file's history"))
+			}).
+			Press(keys.Universal.GoInto)
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("second commit"),
+				Contains("first commit").IsSelected(),
+			)
+	},
+})
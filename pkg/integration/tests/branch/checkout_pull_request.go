@@ -0,0 +1,71 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CheckoutPullRequest = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Check out a pull request by number from the remotes panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Services = map[string]string{"origin": "github:github.com"}
+		// An empty token keeps us from asking the gh command for one
+		config.GetUserConfig().Git.PullRequests.Tokens = map[string]string{"github.com": ""}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("two")
+		shell.RunCommand([]string{"git", "push", "origin", "feature:refs/pull/5/head"})
+		shell.Checkout("master")
+		shell.RunCommand([]string{"git", "branch", "-D", "feature"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.CheckoutPullRequest)
+
+		// The remote's URL isn't one that we can talk to the API for, so
+		// there's no list of pull requests to pick from
+		t.ExpectPopup().Menu().
+			Title(Equals("Check out pull request")).
+			Lines(
+				Contains("Enter pull request number").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Pull request number")).
+			Type("#5").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Local branch for pull request #5")).
+			InitialText(Equals("pr/5")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("pr/5").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Git().
+			CurrentBranchName("pr/5").
+			ConfigValue("branch.pr/5.remote", "origin").
+			ConfigValue("branch.pr/5.merge", "refs/pull/5/head")
+
+		t.Views().Commits().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
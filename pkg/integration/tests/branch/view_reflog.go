@@ -0,0 +1,89 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewReflog = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "View the reflog of a branch that isn't checked out, diff two of its entries, and restore it to an earlier position",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("initial").
+			NewBranch("feature").
+			CreateFileAndAdd("file", "one\n").
+			Commit("one").
+			UpdateFileAndAdd("file", "two\n").
+			Commit("two").
+			Checkout("master").
+			// simulate losing the second commit, e.g. through a force-push
+			RunCommand([]string{"git", "branch", "--force", "feature", "feature^"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+			).
+			SelectNextItem().
+			Press(keys.Branches.ViewReflog)
+
+		t.Views().BranchReflog().
+			IsFocused().
+			Title(Equals("Reflog (feature)")).
+			Lines(
+				Contains("branch: Reset to feature^").IsSelected(),
+				Contains("commit: two"),
+				Contains("commit: one"),
+				Contains("branch: Created from HEAD"),
+			).
+			// the most recent entry is where the branch is now
+			Press(keys.Branches.RestoreFromReflog).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: The branch already points at this entry"))
+			}).
+			Press(keys.Universal.RangeSelectDown).
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("Showing diff between reflog entries").
+						Contains("-two").
+						Contains("+one"))
+			}).
+			// select the entry from before the commit got lost
+			Press(keys.Universal.ToggleRangeSelect).
+			Press(keys.Branches.RestoreFromReflog).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Restore branch")).
+					Content(Contains("Are you sure you want to move branch 'feature'").
+						Contains("(commit: two)").
+						Contains("Its current position will stay in its reflog.")).
+					Confirm()
+			}).
+			TopLines(
+				Contains("branch: Reset to").IsSelected(),
+				Contains("branch: Reset to feature^"),
+				Contains("commit: two"),
+			).
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("feature").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			TopLines(
+				Contains("two"),
+				Contains("one"),
+			)
+	},
+})
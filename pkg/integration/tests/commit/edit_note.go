@@ -0,0 +1,81 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditNote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Add, edit, and remove a note on a commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(Contains("Add/edit note").DoesNotContain("editor")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Note (leave empty to remove)")).
+			Type("reviewed by ci").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				DoesNotContain("✎"),
+				Contains("✎ one").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Notes:").Contains("reviewed by ci"))
+
+		t.Views().Commits().
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(Contains("Add/edit note").DoesNotContain("editor")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Note (leave empty to remove)")).
+			InitialText(Equals("reviewed by ci")).
+			Clear().
+			Type("deployed to staging").
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("deployed to staging").DoesNotContain("reviewed by ci"))
+
+		t.Views().Commits().
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(Contains("Remove note")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("two"),
+				Contains("one").DoesNotContain("✎").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(DoesNotContain("Notes:"))
+	},
+})
@@ -0,0 +1,46 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchNotes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch notes that were pushed to a remote by someone else",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.RunCommand([]string{"git", "notes", "add", "-m", "build passed", "HEAD"})
+		shell.RunCommand([]string{"git", "push", "origin", "refs/notes/*"})
+		shell.RunCommand([]string{"git", "update-ref", "-d", "refs/notes/commits"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one").DoesNotContain("✎").IsSelected(),
+			).
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(Contains("Fetch notes from remote")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Fetch notes from remote")).
+			InitialText(Equals("origin")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("✎ one").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("build passed"))
+	},
+})
@@ -0,0 +1,51 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushNotesOfConfiguredRef = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show notes of the configured notes ref, and push them to the remote of the checked out branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.NotesRef = "ci"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CloneIntoRemote("origin")
+		shell.CloneIntoRemote("fork")
+		shell.SetBranchUpstream("master", "fork/master")
+		shell.RunCommand([]string{"git", "notes", "--ref=ci", "add", "-m", "build passed", "HEAD~1"})
+		shell.RunCommand([]string{"git", "notes", "add", "-m", "note in the default ref", "HEAD"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").DoesNotContain("✎").IsSelected(),
+				Contains("✎ one"),
+			).
+			NavigateToLine(Contains("one"))
+
+		t.Views().Main().
+			Content(Contains("build passed"))
+
+		t.Views().Commits().
+			Press(keys.Commits.ViewNotesOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Notes")).
+			Select(Contains("Push notes to remote")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Push notes to remote")).
+			InitialText(Equals("fork")).
+			Confirm()
+
+		t.Git().RemoteRefExists("fork", "refs/notes/ci")
+	},
+})
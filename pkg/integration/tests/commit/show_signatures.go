@@ -0,0 +1,36 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowSignatures = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the signature status of commits, and the signature of the selected commit in the main view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.34.0"), // first version that supports signing with ssh keys
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.Log.ShowSignatures = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.RunCommand([]string{"ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "test", "-f", "../signing-key"})
+		shell.RunShellCommand(`echo "CI@example.com $(cat ../signing-key.pub)" > ../allowed-signers`)
+		shell.RunShellCommand(`git config user.signingkey "$PWD/../signing-key" && git config gpg.ssh.allowedSignersFile "$PWD/../allowed-signers"`)
+		shell.SetConfig("gpg.format", "ssh")
+
+		shell.EmptyCommit("unsigned commit")
+		shell.RunCommand([]string{"git", "commit", "--allow-empty", "-S", "-m", "signed commit"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("G signed commit").IsSelected(),
+				Contains("N unsigned commit"),
+			)
+
+		t.Views().Main().
+			Content(Contains(`Good "git" signature for CI@example.com`))
+	},
+})
@@ -0,0 +1,51 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ResolveDeleteModifyConflict = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resolve a conflict where one side deleted a file that the other side modified, by using the deleting side's version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			CreateFileAndAdd("file", "original\n").
+			Commit("original").
+			NewBranch("delete-branch").
+			DeleteFileAndAdd("file").
+			Commit("delete file").
+			Checkout("master").
+			UpdateFileAndAdd("file", "changed\n").
+			Commit("change file")
+
+		shell.RunCommandExpectError([]string{"git", "merge", "--no-edit", "delete-branch"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UD").Contains("file").IsSelected(),
+			).
+			Press(keys.Files.OpenMergeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Merge conflict options")).
+					Select(Contains("Use theirs")).
+					Confirm()
+			}).
+			Tap(func() {
+				t.Common().ContinueOnConflictsResolved()
+			}).
+			IsEmpty()
+
+		t.FileSystem().PathNotPresent("file")
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branch 'delete-branch'"),
+			)
+	},
+})
@@ -0,0 +1,78 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveThreeWay = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resolve a conflict in the three-way view by picking lines from both sides and editing the result",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("merge.conflictStyle", "diff3")
+		shared.CreateMergeConflictFile(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< HEAD"),
+				Contains("First Change"),
+				Contains("|||||||"),
+			).
+			Press(keys.Main.ToggleThreeWayView).
+			Content(
+				Contains("Conflict 1 of 1").
+					Contains("Ours (HEAD)").
+					Contains("Theirs (second-change-branch)").
+					Contains("Original").
+					Contains("Pick lines from the panes above to build the result"),
+			).
+			SelectedLines(
+				Contains("First Change").Contains("Original").Contains("Second Change"),
+			).
+			// pick our line
+			PressPrimaryAction().
+			Content(Contains("✓ First Change")).
+			// switch to the theirs pane and pick their line too
+			Press(keys.Universal.TogglePanel).
+			Press(keys.Universal.TogglePanel).
+			PressPrimaryAction().
+			Content(Contains("✓ Second Change")).
+			Press(keys.Universal.New).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Add line to result")).
+					Type("Extra").
+					Confirm()
+			}).
+			SelectedLines(
+				Equals("Extra"),
+			).
+			Press(keys.Main.EditSelectHunk).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Edit result line")).
+					InitialText(Equals("Extra")).
+					Clear().
+					Type("Edited").
+					Confirm()
+			}).
+			Content(Contains("Result").Contains("First Change\nSecond Change\nEdited")).
+			PressEnter()
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.FileSystem().FileContent("file", Equals("\nThis\nIs\nThe\nFirst Change\nSecond Change\nEdited\nFile\n"))
+	},
+})
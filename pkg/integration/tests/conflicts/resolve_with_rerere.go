@@ -0,0 +1,51 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveWithRerere = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show that a conflict was resolved by rerere, and forget the recorded resolution",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("rerere.enabled", "true")
+
+		// resolve the conflict once so that rerere records the resolution, then
+		// redo the merge so that it's applied again
+		shared.CreateMergeCommit(shell)
+		shell.HardReset("HEAD^")
+		shell.RunCommandExpectError([]string{"git", "merge", "--no-edit", "second-change-branch"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.FileSystem().FileContent("file", Equals(shared.SecondChangeFileContent))
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file (resolved by rerere)").IsSelected(),
+			).
+			Press(keys.Files.OpenMergeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Merge conflict options")).
+					Select(Contains("Forget recorded resolution")).
+					Confirm()
+			}).
+			Lines(
+				Equals("UU file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			SelectedLines(
+				Contains("<<<<<<< ours"),
+				Contains("First Change"),
+				Contains("======="),
+			)
+	},
+})
@@ -0,0 +1,52 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveWithVersion = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resolve conflicted files as a whole by using their or our version from the merge conflict options menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFiles(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("UU").Contains("file1").IsSelected(),
+				Contains("UU").Contains("file2"),
+			).
+			Press(keys.Files.OpenMergeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Merge conflict options")).
+					Select(Contains("Use theirs")).
+					Confirm()
+			}).
+			Lines(
+				Contains("UU").Contains("file2").IsSelected(),
+			).
+			PressEnter()
+
+		// the menu can also be opened from the merge conflicts view
+		t.Views().MergeConflicts().
+			IsFocused().
+			Press(keys.Files.OpenMergeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Merge conflict options")).
+					Select(Contains("Use ours")).
+					Confirm()
+			})
+
+		t.Common().ContinueOnConflictsResolved()
+
+		t.FileSystem().FileContent("file1", Equals(shared.SecondChangeFileContent))
+		t.FileSystem().FileContent("file2", Equals(shared.FirstChangeFileContent))
+	},
+})
@@ -0,0 +1,51 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AfterHooks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Focus a panel, select the ref printed by the command, show a toast, and chain another command after a custom command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "files",
+				Command: `git branch branch-0-new && echo branch-0-new`,
+				After: &config.CustomCommandAfterHook{
+					Refresh:    []string{"branches"},
+					Focus:      "localBranches",
+					SelectRef:  "{{.Output}}",
+					Toast:      "Created {{.Output}}",
+					RunCommand: "writeSelectedBranch",
+				},
+			},
+			{
+				Name:    "writeSelectedBranch",
+				Context: "localBranches",
+				Command: `echo {{.SelectedLocalBranch.Name | quote}} > selected-branch`,
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press("X")
+
+		t.ExpectToast(Equals("Created branch-0-new"))
+
+		t.Views().Branches().
+			IsFocused().
+			SelectedLine(Contains("branch-0-new"))
+
+		t.FileSystem().FileContent("selected-branch", Equals("branch-0-new\n"))
+	},
+})
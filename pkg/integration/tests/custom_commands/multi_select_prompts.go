@@ -0,0 +1,89 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MultiSelectPrompts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using multiSelect prompts with static options and with options from a command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("blah")
+		shell.CloneIntoRemote("origin")
+		shell.CloneIntoRemote("upstream")
+		shell.CloneIntoRemote("fork")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `echo "{{range .Form.Services}}[{{.}}]{{end}} {{range .Form.Remotes}}<{{.}}>{{end}} {{index .PromptResponses 1}}" > output.txt`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Key:   "Services",
+						Type:  "multiSelect",
+						Title: "Choose services",
+						Options: []config.CustomCommandMenuOption{
+							{Name: "api", Description: "API server", Value: "API"},
+							{Name: "web", Description: "Web frontend", Value: "WEB"},
+							{Name: "worker", Description: "Background jobs", Value: "WORKER"},
+						},
+					},
+					{
+						Key:         "Remotes",
+						Type:        "multiSelect",
+						Title:       "Choose remotes",
+						Command:     "git remote",
+						Filter:      `(?P<remote>.*)`,
+						ValueFormat: `{{ .remote }}`,
+						LabelFormat: `{{ .remote | green }}`,
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose services")).
+			Select(Contains("api")).
+			Confirm().
+			Select(Contains("worker")).
+			Confirm().
+			Select(Contains("web")).
+			Confirm().
+			Confirm().
+			Lines(
+				Contains("[✓]").Contains("api"),
+				Contains("[ ]").Contains("web"),
+				Contains("[✓]").Contains("worker"),
+				Contains("Confirm selection"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Confirm selection")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Choose remotes")).
+			Select(Contains("upstream")).
+			Confirm().
+			Select(Contains("fork")).
+			Confirm().
+			Select(Contains("Confirm selection")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Contains("output.txt").IsSelected(),
+			)
+
+		t.Views().Main().Content(Contains("[API][WORKER] <fork><upstream> fork upstream"))
+	},
+})
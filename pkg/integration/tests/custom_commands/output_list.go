@@ -0,0 +1,82 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OutputList = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Parse a custom command's output into a list panel, which stays while switching to other panels, and run another custom command on its items",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("jobs.txt", "101 passed build\n102 failed test\n103 running deploy\n")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:         "X",
+				Name:        "jobs",
+				Context:     "files",
+				Command:     "cat jobs.txt",
+				OutputTitle: "CI jobs",
+				OutputList: &config.CustomCommandOutputList{
+					Filter:      `^(?P<id>\d+) (?P<status>\S+) (?P<name>.*)$`,
+					ValueFormat: "{{ .id }}",
+					LabelFormat: "{{ .name }}: {{ .status }}",
+				},
+			},
+			{
+				Key:     "r",
+				Context: "customList",
+				Command: `sed -i 's/^{{.SelectedCustomListItem.Value}} [a-z]*/{{.SelectedCustomListItem.Value}} retried/' jobs.txt`,
+				After: &config.CustomCommandAfterHook{
+					RunCommand: "jobs",
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press("X")
+
+		t.Views().CustomList().
+			IsFocused().
+			Title(Equals("CI jobs")).
+			Lines(
+				Equals("build: passed").IsSelected(),
+				Equals("test: failed"),
+				Equals("deploy: running"),
+			).
+			NavigateToLine(Contains("test"))
+
+		t.Views().Main().Content(Equals("102 failed test"))
+
+		t.Views().CustomList().
+			Press("r").
+			Lines(
+				Equals("build: passed"),
+				Equals("test: retried").IsSelected(),
+				Equals("deploy: running"),
+			)
+
+		// Switching to another panel and back returns to the list
+		t.Views().CustomList().Press(keys.Universal.JumpToBlock[2])
+		t.Views().Branches().
+			IsFocused().
+			Press(keys.Universal.JumpToBlock[1])
+		t.Views().CustomList().
+			IsFocused().
+			Lines(
+				Equals("build: passed"),
+				Equals("test: retried").IsSelected(),
+				Equals("deploy: running"),
+			).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
@@ -0,0 +1,46 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use the {{ .SelectedCommits }} template variable in a command that requires a range selection",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(4)
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:                "X",
+				Context:            "global",
+				RequireRangeSelect: true,
+				Command:            `printf "%s\n" {{range .SelectedCommits}}{{.Name | quote}} {{end}} > file.txt`,
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().Focus().
+			Lines(
+				Contains("commit 04").IsSelected(),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.GlobalPress("X")
+		t.ExpectToast(Equals("Disabled: This command requires selecting a range of items"))
+		t.FileSystem().PathNotPresent("file.txt")
+
+		t.Views().Commits().
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown)
+
+		t.GlobalPress("X")
+		t.FileSystem().FileContent("file.txt", Equals("commit 03\ncommit 02\ncommit 01\n"))
+	},
+})
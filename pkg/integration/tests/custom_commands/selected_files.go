@@ -0,0 +1,49 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectedFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use the {{ .SelectedFiles }} template variable with a range selection that includes a directory",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFile("a.txt", "")
+		shell.CreateFile("dir/b.txt", "")
+		shell.CreateFile("dir/c.txt", "")
+		shell.CreateFile("d.txt", "")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "files",
+				Command: `git add {{range .SelectedFiles}}{{.Name | quote}} {{end}}`,
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("  ?? b.txt"),
+				Equals("  ?? c.txt"),
+				Equals("?? a.txt"),
+				Equals("?? d.txt"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press("X").
+			Lines(
+				Equals("▼ dir"),
+				Equals("  A  b.txt"),
+				Equals("  A  c.txt"),
+				Equals("A  a.txt"),
+				Equals("?? d.txt"),
+			)
+	},
+})
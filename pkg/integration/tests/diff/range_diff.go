@@ -0,0 +1,83 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RangeDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare a rewritten branch with its upstream using range-diff",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.NewBranch("topic")
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+		shell.Commit("add file1")
+		shell.CreateFileAndAdd("file2", "content")
+		shell.Commit("add file2")
+		shell.CreateFileAndAdd("file3", "content")
+		shell.Commit("add file3")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("topic", "origin/topic")
+
+		// Rewrite the branch: change the first commit, keep the second, drop
+		// the third and add a new one
+		shell.HardReset("HEAD~3")
+		shell.CreateFileAndAdd("file1", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n")
+		shell.Commit("add file1")
+		shell.CreateFileAndAdd("file2", "content")
+		shell.Commit("add file2")
+		shell.CreateFileAndAdd("file4", "content")
+		shell.Commit("add file4")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("topic").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Universal.DiffingMenu)
+
+		t.ExpectPopup().Menu().Title(Equals("Diffing")).
+			Select(Contains("Show range-diff of topic@{u} and topic")).
+			Confirm()
+
+		t.Views().RangeDiff().
+			IsFocused().
+			Title(Equals("Range-diff: topic@{u} vs topic")).
+			Lines(
+				Contains("!").Contains("add file1").IsSelected(),
+				Contains("=").Contains("add file2"),
+				Contains("<").Contains("add file3"),
+				Contains(">").Contains("add file4"),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("++11"))
+			}).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("The patches of both commits are identical.")).
+					Content(Contains("+content"))
+			}).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("This commit only exists in the old version.")).
+					Content(Contains("file3"))
+			}).
+			SelectNextItem().
+			Tap(func() {
+				t.Views().Main().
+					Content(Contains("This commit only exists in the new version.")).
+					Content(Contains("file4"))
+			}).
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused()
+	},
+})
@@ -40,8 +40,11 @@ var EditRangeSelectOutsideRebase = NewIntegrationTest(NewIntegrationTestArgs{
 			Lines(
 				Contains("merge  CI Merge branch 'second-change-branch' into first-change-branch").IsSelected(),
 				Contains("edit   CI first change").IsSelected(),
+				Contains("reset     branch-point").IsSelected(),
+				Contains("label     second-change-branch").IsSelected(),
 				Contains("edit   CI * second-change-branch unrelated change").IsSelected(),
 				Contains("edit   CI second change").IsSelected(),
+				Contains("label     branch-point").IsSelected(),
 				Contains("edit   CI * original").IsSelected(),
 				Contains("       CI ◯ <-- YOU ARE HERE --- three").IsSelected(),
 				Contains("       CI ◯ two"),
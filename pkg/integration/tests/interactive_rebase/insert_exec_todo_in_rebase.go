@@ -0,0 +1,72 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var InsertExecTodoInRebase = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Insert exec todos after a range of todos in a rebase, then move and drop one of them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(4)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("pick").Contains("CI commit 04"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("pick").Contains("CI commit 02"),
+				Contains("CI ◯ <-- YOU ARE HERE --- commit 01").IsSelected(),
+			).
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.InsertExecTodo).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to execute:")).
+					Type("make test").
+					Confirm()
+			}).
+			Lines(
+				Contains("pick").Contains("CI commit 04"),
+				Contains("exec").Contains("make test"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("exec").Contains("make test"),
+				Contains("pick").Contains("CI commit 02"),
+				Contains("CI ◯ <-- YOU ARE HERE --- commit 01"),
+			).
+			NavigateToLine(Contains("commit 04")).
+			SelectNextItem().
+			Press(keys.Commits.MoveUpCommit).
+			Lines(
+				Contains("exec").Contains("make test").IsSelected(),
+				Contains("pick").Contains("CI commit 04"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("exec").Contains("make test"),
+				Contains("pick").Contains("CI commit 02"),
+				Contains("CI ◯ <-- YOU ARE HERE --- commit 01"),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("Execute the following command here:").Contains("make test"))
+			}).
+			NavigateToLine(Contains("commit 03")).
+			SelectNextItem().
+			Press(keys.Universal.Remove).
+			Lines(
+				Contains("exec").Contains("make test"),
+				Contains("pick").Contains("CI commit 04"),
+				Contains("pick").Contains("CI commit 03"),
+				Contains("pick").Contains("CI commit 02").IsSelected(),
+				Contains("CI ◯ <-- YOU ARE HERE --- commit 01"),
+			)
+
+		t.FileSystem().FileContent(".git/rebase-merge/git-rebase-todo",
+			Contains("pick").Contains("commit 02\npick").Contains("commit 03\npick").Contains("commit 04\nexec make test\n"))
+	},
+})
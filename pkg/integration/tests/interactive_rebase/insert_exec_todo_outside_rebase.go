@@ -0,0 +1,37 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var InsertExecTodoOutsideRebase = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run a command after each of a range of commits by inserting exec todos outside of a rebase",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(4)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Commits.InsertExecTodo).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to execute:")).
+					Type("git log -1 --format=%s >> ../exec-log").
+					Confirm()
+			}).
+			Lines(
+				Contains("CI ◯ commit 04"),
+				Contains("CI ◯ commit 03").IsSelected(),
+				Contains("CI ◯ commit 02").IsSelected(),
+				Contains("CI ◯ commit 01"),
+			)
+
+		t.FileSystem().FileContent("../exec-log", Equals("commit 02\ncommit 03\n"))
+	},
+})
@@ -0,0 +1,80 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var MoveLabelAndResetTodos = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show label, reset, and merge todos of a rebase with merges, and move them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.22.0"), // first version that supports the --rebase-merges option
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeCommit(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("original")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("merge").Contains("Merge branch 'second-change-branch' into first-change-branch"),
+				Contains("pick").Contains("first change"),
+				Contains("reset").Contains("branch-point"),
+				Contains("label").Contains("second-change-branch"),
+				Contains("pick").Contains("second-change-branch unrelated change"),
+				Contains("pick").Contains("second change"),
+				Contains("label").Contains("branch-point"),
+				Contains("<-- YOU ARE HERE --- * original"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("reset")).
+			Tap(func() {
+				t.Views().Main().Content(Equals("Reset HEAD to the commit labeled 'branch-point'"))
+			}).
+			NavigateToLine(Contains("label").Contains("second-change-branch")).
+			Tap(func() {
+				t.Views().Main().Content(Equals("Label the current commit as 'second-change-branch'"))
+			}).
+			Press(keys.Commits.MoveDownCommit).
+			Lines(
+				Contains("merge").Contains("Merge branch 'second-change-branch' into first-change-branch"),
+				Contains("pick").Contains("first change"),
+				Contains("reset").Contains("branch-point"),
+				Contains("pick").Contains("second-change-branch unrelated change"),
+				Contains("label").Contains("second-change-branch").IsSelected(),
+				Contains("pick").Contains("second change"),
+				Contains("label").Contains("branch-point"),
+				Contains("<-- YOU ARE HERE --- * original"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("merge")).
+			Press(keys.Commits.MoveDownCommit).
+			Lines(
+				Contains("pick").Contains("first change"),
+				Contains("merge").Contains("Merge branch 'second-change-branch' into first-change-branch").IsSelected(),
+				Contains("reset").Contains("branch-point"),
+				Contains("pick").Contains("second-change-branch unrelated change"),
+				Contains("label").Contains("second-change-branch"),
+				Contains("pick").Contains("second change"),
+				Contains("label").Contains("branch-point"),
+				Contains("<-- YOU ARE HERE --- * original"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			).
+			Tap(func() {
+				t.FileSystem().FileContent(".git/rebase-merge/git-rebase-todo",
+					Contains("label branch-point\n").
+						Contains("reset branch-point\n").
+						Contains("second change\nlabel second-change-branch\npick"))
+			})
+	},
+})
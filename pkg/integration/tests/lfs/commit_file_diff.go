@@ -0,0 +1,63 @@
+package lfs
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitFileDiff = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a summary of the change to an LFS object instead of the pointer diff in the commit files view",
+	ExtraCmdArgs: []string{},
+	ExtraEnvVars: fakeLfsEnvVars,
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		setupFakeLfs(shell)
+		shell.Commit("add .gitattributes")
+		shell.CreateFileAndAdd("image.psd", lfsPointer("1111", 800))
+		shell.Commit("add image")
+		shell.UpdateFileAndAdd("image.psd", lfsPointer("2222", 1200))
+		shell.CreateFileAndAdd("notes.txt", "some notes")
+		shell.Commit("update image")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("update image").IsSelected(),
+				Contains("add image"),
+				Contains("add .gitattributes"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("image.psd").IsSelected(),
+				Contains("notes.txt"),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("LFS object changed (800 B → 1.2 KB)").
+					Contains("- sha256:1111").
+					Contains("+ sha256:2222"),
+			)
+
+		t.Views().CommitFiles().
+			NavigateToLine(Contains("notes.txt"))
+
+		t.Views().Main().
+			Content(Contains("+some notes"))
+
+		t.Views().CommitFiles().
+			PressEscape()
+
+		t.Views().Commits().
+			NavigateToLine(Contains("add image")).
+			PressEnter()
+
+		t.Views().Main().
+			Content(Contains("LFS object added (800 B)"))
+	},
+})
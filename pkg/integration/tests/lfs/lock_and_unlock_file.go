@@ -0,0 +1,67 @@
+package lfs
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LockAndUnlockFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show that a file is tracked by LFS and lock and unlock it",
+	ExtraCmdArgs: []string{},
+	ExtraEnvVars: fakeLfsEnvVars,
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		setupFakeLfs(shell)
+		shell.CreateFileAndAdd("image.psd", lfsPointer("1111", 1500))
+		shell.CreateFileAndAdd("notes.txt", "some notes")
+		shell.Commit("add image")
+		shell.UpdateFile("image.psd", lfsPointer("2222", 2500000))
+		shell.UpdateFile("notes.txt", "some more notes")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M image.psd (LFS)").IsSelected(),
+				Equals(" M notes.txt"),
+			)
+
+		t.Views().Main().
+			Content(Contains("LFS object changed (1.5 KB → 2.5 MB)").DoesNotContain("version https://git-lfs"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewLfsOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Git LFS")).
+					Select(Contains("Lock selected file")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M image.psd (LFS, locked)").IsSelected(),
+				Equals(" M notes.txt"),
+			).
+			Press(keys.Files.ViewLfsOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Git LFS")).
+					Select(Contains("Unlock selected file")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M image.psd (LFS)").IsSelected(),
+				Equals(" M notes.txt"),
+			).
+			NavigateToLine(Contains("notes.txt")).
+			Press(keys.Files.ViewLfsOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Git LFS")).
+					Select(Contains("Lock selected file")).
+					Confirm()
+
+				t.ExpectToast(Equals("Disabled: The selected item is not a file tracked by Git LFS"))
+			})
+	},
+})
@@ -0,0 +1,60 @@
+package lfs
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LockFileByPath = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Lock an unmodified file by path, so that it shows as locked once it is modified",
+	ExtraCmdArgs: []string{},
+	ExtraEnvVars: fakeLfsEnvVars,
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		setupFakeLfs(shell)
+		shell.CreateFileAndAdd("image.psd", lfsPointer("1111", 1500))
+		shell.Commit("add image")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			IsEmpty().
+			Press(keys.Files.ViewLfsOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Git LFS")).
+			Select(Contains("Lock file by path")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path of file to lock")).
+			Type("image.psd").
+			Confirm()
+
+		t.Shell().UpdateFile("image.psd", lfsPointer("2222", 2500))
+
+		t.Views().Files().
+			Press(keys.Files.RefreshFiles).
+			Lines(
+				Equals(" M image.psd (LFS, locked)"),
+			).
+			Press(keys.Files.ViewLfsOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Git LFS")).
+			Select(Contains("Unlock file by path")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Path of file to unlock")).
+			Type("ima").
+			SuggestionLines(Equals("image.psd")).
+			ConfirmFirstSuggestion()
+
+		t.Views().Files().
+			Lines(
+				Equals(" M image.psd (LFS)"),
+			)
+	},
+})
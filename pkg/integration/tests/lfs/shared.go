@@ -0,0 +1,51 @@
+package lfs
+
+import (
+	"fmt"
+	"os"
+
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We don't want to depend on git-lfs being installed (or talk to an LFS
+// server), so we put a fake git-lfs on the PATH that only knows how to manage
+// locks, which it keeps in a plain text file.
+const fakeLfsScript = `#!/bin/sh
+locks_file="$(git rev-parse --git-dir)/fake-lfs-locks"
+touch "$locks_file"
+for path; do :; done
+case "$1" in
+lock)
+	echo "$path" >> "$locks_file"
+	;;
+unlock)
+	grep -v -x -F "$path" "$locks_file" > "$locks_file.tmp"
+	mv "$locks_file.tmp" "$locks_file"
+	;;
+locks)
+	printf '['
+	id=1
+	while read -r locked_path; do
+		[ "$id" -gt 1 ] && printf ','
+		printf '{"id":"%s","path":"%s","owner":{"name":"CI"}}' "$id" "$locked_path"
+		id=$((id+1))
+	done < "$locks_file"
+	printf ']'
+	;;
+esac
+`
+
+var fakeLfsEnvVars = map[string]string{
+	"PATH": "{{actualPath}}/bin" + string(os.PathListSeparator) + os.Getenv("PATH"),
+}
+
+func setupFakeLfs(shell *Shell) {
+	shell.CreateFile("../bin/git-lfs", fakeLfsScript)
+	shell.RunCommand([]string{"chmod", "+x", "../bin/git-lfs"})
+	shell.CreateFileAndAdd(".gitattributes", "*.psd filter=lfs diff=lfs merge=lfs -text\n")
+}
+
+// Returns the content of the pointer file that git stores for an LFS object
+func lfsPointer(oid string, size int) string {
+	return fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n", oid, size)
+}
@@ -0,0 +1,108 @@
+package sparse_checkout
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EnableAndModify = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Enable a sparse-checkout from the status panel, add and remove directories, then disable it again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("root-file", "root")
+		shell.CreateFileAndAdd("app/main.go", "main")
+		shell.CreateFileAndAdd("docs/index.md", "docs")
+		shell.CreateFileAndAdd("vendor/lib.go", "lib")
+		shell.Commit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(DoesNotContain("(sparse)")).
+			Focus().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse-checkout")).
+			Select(Contains("Enable sparse-checkout")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directories to check out (separated by spaces):")).
+			Type("app").
+			SuggestionLines(
+				Contains("app"),
+			).
+			Confirm()
+
+		t.Views().Status().
+			Content(Contains("(sparse)"))
+
+		t.FileSystem().PathPresent("root-file")
+		t.FileSystem().PathPresent("app/main.go")
+		t.FileSystem().PathNotPresent("docs")
+		t.FileSystem().PathNotPresent("vendor")
+
+		t.Views().Status().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse-checkout")).
+			Lines(
+				Contains("Directories"),
+				Contains("app").IsSelected(),
+				Contains(""),
+				Contains("Actions"),
+				Contains("a Add directory"),
+				Contains("r Reapply"),
+				Contains("d Disable sparse-checkout"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Add directory")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Directories to add (separated by spaces):")).
+			Type("docs").
+			Confirm()
+
+		t.FileSystem().PathPresent("docs/index.md")
+		t.FileSystem().PathNotPresent("vendor")
+
+		t.Views().Status().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse-checkout")).
+			Select(Equals("  app")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Remove directory")).
+			Content(Contains("Are you sure you want to remove 'app' from the sparse-checkout?")).
+			Confirm()
+
+		t.FileSystem().PathNotPresent("app")
+		t.FileSystem().PathPresent("docs/index.md")
+
+		t.Views().Status().
+			Press(keys.Status.SparseCheckoutOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Sparse-checkout")).
+			Select(Contains("Disable sparse-checkout")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Disable sparse-checkout")).
+			Content(Contains("All files will be checked out into the working tree.")).
+			Confirm()
+
+		t.Views().Status().
+			Content(DoesNotContain("(sparse)"))
+
+		t.FileSystem().PathPresent("app/main.go")
+		t.FileSystem().PathPresent("vendor/lib.go")
+	},
+})
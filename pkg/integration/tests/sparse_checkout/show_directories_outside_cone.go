@@ -0,0 +1,34 @@
+package sparse_checkout
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowDirectoriesOutsideCone = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Directories outside of the sparse-checkout cone are marked in the files panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("app/main.go", "main")
+		shell.CreateFileAndAdd("vendor/lib.go", "lib")
+		shell.Commit("initial commit")
+		shell.RunCommand([]string{"git", "sparse-checkout", "set", "--cone", "app"})
+
+		shell.UpdateFile("app/main.go", "changed")
+		shell.CreateFile("vendor/new.go", "new")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Content(Contains("(sparse)"))
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ app").IsSelected(),
+				Equals("   M main.go"),
+				Equals("▼ vendor (outside sparse-checkout cone)"),
+				Equals("  ?? new.go"),
+			)
+	},
+})
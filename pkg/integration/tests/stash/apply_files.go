@@ -0,0 +1,54 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Restore some of the files of a stash entry by applying them to the working tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("file1", "content")
+		shell.CreateFile("file2", "content")
+		shell.CreateFile("file3", "content")
+		shell.GitAddAll()
+		shell.Stash("stash one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsEmpty()
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+				Contains("file2"),
+				Contains("file3"),
+			).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Contains("file2")).
+			Press(keys.CommitFiles.ApplyToWorkingTree)
+
+		t.Views().Files().
+			Lines(
+				Contains("file1"),
+				Contains("file2"),
+			)
+
+		// The stash entry is left alone
+		t.Views().Stash().
+			Lines(
+				Contains("stash one"),
+			)
+	},
+})
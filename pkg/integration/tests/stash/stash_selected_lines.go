@@ -0,0 +1,59 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash some of the unstaged lines of a file from the staging panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "line1\nline2\nline3\nline4\nline5\nline6\nline7\nline8\n")
+		shell.Commit("initial commit")
+		// Stage a change in the same file to make sure it's neither stashed
+		// nor does it get in the way
+		shell.UpdateFileAndAdd("file", "line1 staged\nline2\nline3\nline4\nline5\nline6\nline7\nline8\n")
+		shell.UpdateFile("file", "line1 staged\nline2\nline3\nline4 mod\nline5\nline6\nline7\nline8 mod\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("-line4")).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Contains("+line4 mod")).
+			Press(keys.Files.StashAllChanges).
+			Tap(func() {
+				t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("my partial stash").Confirm()
+			}).
+			Content(DoesNotContain("line4 mod")).
+			Content(Contains("-line8\n+line8 mod"))
+
+		t.Views().StagingSecondary().
+			Content(Contains("-line1\n+line1 staged"))
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("my partial stash"),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains(" line3\n-line4\n+line4 mod\n line5")).
+			Content(DoesNotContain("+line1 staged")).
+			Content(DoesNotContain("line8 mod"))
+	},
+})
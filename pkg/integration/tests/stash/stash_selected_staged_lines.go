@@ -0,0 +1,53 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedStagedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash a staged hunk from the staging panel and restore it to the index",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "line1\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\nline10\n")
+		shell.Commit("initial commit")
+		shell.UpdateFileAndAdd("file", "line1 mod\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\nline10 mod\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressEnter()
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			SelectedLines(Contains("-line1")).
+			Press(keys.Main.ToggleSelectHunk).
+			Press(keys.Files.StashAllChanges).
+			Tap(func() {
+				t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("my staged hunk").Confirm()
+			}).
+			Content(DoesNotContain("line1 mod")).
+			Content(Contains("-line10\n+line10 mod"))
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("my staged hunk"),
+			).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Stash apply")).
+			Content(Contains("Are you sure you want to apply this stash entry?")).
+			Confirm()
+
+		// The changes are restored to the working tree only, as we don't use
+		// --index when applying a stash
+		t.Views().Files().
+			Lines(
+				Contains("MM").Contains("file"),
+			)
+	},
+})
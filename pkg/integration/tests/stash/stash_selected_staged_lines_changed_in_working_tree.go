@@ -0,0 +1,52 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedStagedLinesChangedInWorkingTree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Try to stash a staged hunk whose lines have changed again in the working tree, which fails without creating a stash entry",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "line1\nline2\nline3\n")
+		shell.Commit("initial commit")
+		shell.UpdateFileAndAdd("file", "line1 staged\nline2\nline3\n")
+		shell.UpdateFile("file", "line1 unstaged\nline2\nline3\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("MM").Contains("file"),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			SelectedLines(Contains("-line1")).
+			Press(keys.Main.ToggleSelectHunk).
+			Press(keys.Files.StashAllChanges).
+			Tap(func() {
+				t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("my staged hunk").Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("patch does not apply")).
+					Confirm()
+			}).
+			Content(Contains("+line1 staged"))
+
+		t.Views().Staging().
+			Content(Contains("-line1 staged\n+line1 unstaged"))
+
+		t.Views().Stash().
+			IsEmpty()
+	},
+})
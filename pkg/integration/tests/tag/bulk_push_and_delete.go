@@ -0,0 +1,79 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BulkPushAndDelete = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a range of tags, see which tags aren't on the remote, and delete a range of tags locally and remotely",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("one", "2024-01-01 10:00:00")
+		shell.CreateLightweightTag("tag-1", "HEAD")
+		shell.CloneIntoRemote("origin")
+		shell.EmptyCommitWithDate("two", "2024-01-02 10:00:00")
+		shell.CreateLightweightTag("tag-2", "HEAD")
+		shell.EmptyCommitWithDate("three", "2024-01-03 10:00:00")
+		shell.CreateLightweightTag("tag-3", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("tag-3").IsSelected(),
+				Contains("tag-2"),
+				Contains("tag-1"),
+			).
+			Press(keys.Branches.PushTag).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Remote to push tag 'tag-3' to:")).
+					Confirm()
+			}).
+			// Having pushed to origin, we know which tags it's missing
+			Lines(
+				Contains("tag-3").DoesNotContain("↑").IsSelected(),
+				Contains("tag-2 ↑"),
+				Contains("tag-1").DoesNotContain("↑"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Branches.PushTag).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Remote to push the selected tags to:")).
+					Confirm()
+			}).
+			Lines(
+				Contains("tag-3").DoesNotContain("↑").IsSelected(),
+				Contains("tag-2").DoesNotContain("↑").IsSelected(),
+				Contains("tag-1").DoesNotContain("↑"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Delete selected tags?")).
+					Select(Contains("Delete local and remote tags")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Remote from which to remove the selected tags:")).
+					InitialText(Equals("origin")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Delete selected tags?")).
+					Content(Equals("Are you sure you want to delete the selected tags from both your machine and from 'origin'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("tag-1").IsSelected(),
+			)
+
+		t.Shell().
+			AssertRemoteTagNotFound("origin", "tag-2").
+			AssertRemoteTagNotFound("origin", "tag-3")
+	},
+})
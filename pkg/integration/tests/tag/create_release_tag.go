@@ -0,0 +1,90 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateReleaseTag = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a release tag for a commit from the suggested next versions, with a message listing the commits since the latest release",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("one", "2024-01-01 10:00:00")
+		shell.CreateLightweightTag("v0.9.0", "HEAD")
+		shell.EmptyCommitWithDate("two", "2024-01-02 10:00:00")
+		shell.CreateLightweightTag("v1.2.3", "HEAD")
+		shell.CreateLightweightTag("nightly", "HEAD")
+		shell.EmptyCommitWithDate("three", "2024-01-03 10:00:00")
+		shell.EmptyCommitWithDate("four", "2024-01-04 10:00:00")
+		shell.EmptyCommitWithDate("five", "2024-01-05 10:00:00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("four")).
+			Press(keys.Commits.CreateReleaseTag)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Create release tag")).
+			Lines(
+				Contains("Patch").Contains("v1.2.4").IsSelected(),
+				Contains("Minor").Contains("v1.3.0"),
+				Contains("Major").Contains("v2.0.0"),
+				Contains("Pre-release").Contains("v1.2.4-rc.1"),
+				Contains("Cancel"),
+			).
+			Tooltip(Contains("Commits since v1.2.3:")).
+			Tooltip(Contains("three")).
+			Tooltip(Contains("four")).
+			Tooltip(DoesNotContain("five")).
+			Select(Contains("Minor")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Tag name")).
+			InitialText(Equals("v1.3.0")).
+			SwitchToDescription().
+			Content(Contains("Release v1.3.0")).
+			Content(Contains("Changes since v1.2.3:")).
+			Content(Contains("three")).
+			Content(Contains("four")).
+			Content(DoesNotContain("five")).
+			SwitchToSummary().
+			Confirm()
+
+		t.Git().TagNamesAt("HEAD^", []string{"v1.3.0"})
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.3.0").Contains("Release v1.3.0").IsSelected(),
+				Contains("nightly"),
+				Contains("v1.2.3"),
+				Contains("v0.9.0"),
+			).
+			Press(keys.Branches.CreateReleaseTag)
+
+		// From the tags panel, the current commit is tagged
+		t.ExpectPopup().Menu().
+			Title(Equals("Create release tag")).
+			Lines(
+				Contains("Patch").Contains("v1.3.1").IsSelected(),
+				Contains("Minor").Contains("v1.4.0"),
+				Contains("Major").Contains("v2.0.0"),
+				Contains("Pre-release").Contains("v1.3.1-rc.1"),
+				Contains("Cancel"),
+			).
+			Tooltip(Contains("Commits since v1.3.0:")).
+			Tooltip(Contains("five")).
+			Select(Contains("Pre-release")).
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().
+			InitialText(Equals("v1.3.1-rc.1")).
+			Confirm()
+
+		t.Git().TagNamesAt("HEAD", []string{"v1.3.1-rc.1"})
+	},
+})
@@ -0,0 +1,45 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowTagDetails = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the tagger, signature status and full message of an annotated tag, and don't allow verifying a lightweight tag",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("one", "2024-01-01 10:00:00")
+		shell.CreateLightweightTag("lightweight", "HEAD")
+		shell.EmptyCommitWithDate("two", "2024-01-02 10:00:00")
+		shell.CreateAnnotatedTag("v1.0", "Release 1.0\n\nSecond line of the message", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.0").Contains("Release 1.0").IsSelected(),
+				Contains("lightweight").Contains("one"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Tagger:")).
+			Content(Contains("Signature:  Not signed")).
+			Content(Contains("Release 1.0")).
+			Content(Contains("Second line of the message"))
+
+		t.Views().Tags().
+			NavigateToLine(Contains("lightweight"))
+
+		t.Views().Main().
+			Content(Contains("Lightweight tag")).
+			Content(DoesNotContain("Tagger:"))
+
+		t.Views().Tags().
+			Press(keys.Branches.VerifyTag)
+
+		t.ExpectToast(Equals("Disabled: Lightweight tags can't be signed"))
+	},
+})
@@ -0,0 +1,54 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowTagsMissingOnUpstreamRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark the tags that are missing on the remote of the checked out branch, even without auto-fetch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.AutoFetch = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("one", "2024-01-01 10:00:00")
+		shell.CreateLightweightTag("v1.0", "HEAD")
+		shell.CloneIntoRemote("origin")
+		shell.CloneIntoRemote("fork")
+		shell.SetBranchUpstream("master", "fork/master")
+		shell.EmptyCommitWithDate("two", "2024-01-02 10:00:00")
+		shell.CreateLightweightTag("v1.1", "HEAD")
+		shell.RunCommand([]string{"git", "push", "origin", "v1.1"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.1 ↑").IsSelected(),
+				Contains("v1.0").DoesNotContain("↑"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Not on fork"))
+
+		t.Views().Tags().
+			Press(keys.Branches.PushTag)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Remote to push tag 'v1.1' to:")).
+			Clear().
+			Type("fork").
+			Confirm()
+
+		t.Views().Tags().
+			Lines(
+				Contains("v1.1").DoesNotContain("↑").IsSelected(),
+				Contains("v1.0").DoesNotContain("↑"),
+			)
+
+		t.Views().Main().
+			Content(Contains("On fork").DoesNotContain("Not on fork"))
+	},
+})
@@ -5,6 +5,7 @@ package tests
 import (
 	"github.com/jesseduffield/lazygit/pkg/integration/components"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/bisect"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/blame"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/branch"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/cherry_pick"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/commit"
@@ -18,10 +19,12 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_author"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_path"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/interactive_rebase"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/lfs"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/reflog"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/shell_commands"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/sparse_checkout"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/staging"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/stash"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/status"
@@ -38,8 +41,11 @@ var tests = []*components.IntegrationTest{
 	bisect.ChooseTerms,
 	bisect.FromOtherBranch,
 	bisect.Skip,
+	blame.BlameCommitFile,
+	blame.BlameFile,
 	branch.CheckoutAutostash,
 	branch.CheckoutByName,
+	branch.CheckoutPullRequest,
 	branch.CreateTag,
 	branch.Delete,
 	branch.DeleteMultiple,
@@ -76,6 +82,7 @@ var tests = []*components.IntegrationTest{
 	branch.SquashMerge,
 	branch.Suggestions,
 	branch.UnsetUpstream,
+	branch.ViewReflog,
 	cherry_pick.CherryPick,
 	cherry_pick.CherryPickConflicts,
 	cherry_pick.CherryPickDuringRebase,
@@ -105,6 +112,8 @@ var tests = []*components.IntegrationTest{
 	commit.CreateTag,
 	commit.DisableCopyCommitMessageBody,
 	commit.DiscardOldFileChanges,
+	commit.EditNote,
+	commit.FetchNotes,
 	commit.FindBaseCommitForFixup,
 	commit.FindBaseCommitForFixupDisregardMainBranch,
 	commit.FindBaseCommitForFixupOnlyAddedLines,
@@ -117,6 +126,7 @@ var tests = []*components.IntegrationTest{
 	commit.PasteCommitMessage,
 	commit.PasteCommitMessageOverExisting,
 	commit.PreserveCommitMessage,
+	commit.PushNotesOfConfiguredRef,
 	commit.ResetAuthor,
 	commit.ResetAuthorRange,
 	commit.Revert,
@@ -125,6 +135,7 @@ var tests = []*components.IntegrationTest{
 	commit.Search,
 	commit.SetAuthor,
 	commit.SetAuthorRange,
+	commit.ShowSignatures,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
@@ -132,12 +143,17 @@ var tests = []*components.IntegrationTest{
 	config.CustomCommandsInPerRepoConfig,
 	config.RemoteNamedStar,
 	conflicts.Filter,
+	conflicts.ResolveDeleteModifyConflict,
 	conflicts.ResolveExternally,
 	conflicts.ResolveMultipleFiles,
 	conflicts.ResolveNoAutoStage,
+	conflicts.ResolveThreeWay,
+	conflicts.ResolveWithRerere,
+	conflicts.ResolveWithVersion,
 	conflicts.ResolveWithoutTrailingLf,
 	conflicts.UndoChooseHunk,
 	custom_commands.AccessCommitProperties,
+	custom_commands.AfterHooks,
 	custom_commands.BasicCommand,
 	custom_commands.CheckForConflicts,
 	custom_commands.CustomCommandsSubmenu,
@@ -145,10 +161,14 @@ var tests = []*components.IntegrationTest{
 	custom_commands.GlobalContext,
 	custom_commands.MenuFromCommand,
 	custom_commands.MenuFromCommandsOutput,
+	custom_commands.MultiSelectPrompts,
 	custom_commands.MultipleContexts,
 	custom_commands.MultiplePrompts,
+	custom_commands.OutputList,
 	custom_commands.SelectedCommit,
 	custom_commands.SelectedCommitRange,
+	custom_commands.SelectedCommits,
+	custom_commands.SelectedFiles,
 	custom_commands.SelectedPath,
 	custom_commands.ShowOutputInPanel,
 	custom_commands.SuggestionsCommand,
@@ -174,6 +194,7 @@ var tests = []*components.IntegrationTest{
 	diff.DiffCommits,
 	diff.DiffNonStickyRange,
 	diff.IgnoreWhitespace,
+	diff.RangeDiff,
 	diff.RenameSimilarityThresholdChange,
 	file.CollapseExpand,
 	file.CopyMenu,
@@ -234,11 +255,14 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.EditTheConflCommit,
 	interactive_rebase.FixupFirstCommit,
 	interactive_rebase.FixupSecondCommit,
+	interactive_rebase.InsertExecTodoInRebase,
+	interactive_rebase.InsertExecTodoOutsideRebase,
 	interactive_rebase.InteractiveRebaseOfCopiedBranch,
 	interactive_rebase.MidRebaseRangeSelect,
 	interactive_rebase.Move,
 	interactive_rebase.MoveAcrossBranchBoundaryOutsideRebase,
 	interactive_rebase.MoveInRebase,
+	interactive_rebase.MoveLabelAndResetTodos,
 	interactive_rebase.MoveUpdateRefTodo,
 	interactive_rebase.MoveWithCustomCommentChar,
 	interactive_rebase.OutsideRebaseRangeSelect,
@@ -262,6 +286,9 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
 	interactive_rebase.ViewFilesOfTodoEntries,
+	lfs.CommitFileDiff,
+	lfs.LockAndUnlockFile,
+	lfs.LockFileByPath,
 	misc.ConfirmOnQuit,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
@@ -305,6 +332,8 @@ var tests = []*components.IntegrationTest{
 	shell_commands.EditHistory,
 	shell_commands.History,
 	shell_commands.OmitFromHistory,
+	sparse_checkout.EnableAndModify,
+	sparse_checkout.ShowDirectoriesOutsideCone,
 	staging.DiffChangeScreenMode,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
@@ -313,6 +342,7 @@ var tests = []*components.IntegrationTest{
 	staging.StageLines,
 	staging.StageRanges,
 	stash.Apply,
+	stash.ApplyFiles,
 	stash.ApplyPatch,
 	stash.CreateBranch,
 	stash.Drop,
@@ -323,6 +353,9 @@ var tests = []*components.IntegrationTest{
 	stash.StashAll,
 	stash.StashAndKeepIndex,
 	stash.StashIncludingUntrackedFiles,
+	stash.StashSelectedLines,
+	stash.StashSelectedStagedLines,
+	stash.StashSelectedStagedLinesChangedInWorkingTree,
 	stash.StashStaged,
 	stash.StashStagedPartialFile,
 	stash.StashUnstaged,
@@ -360,9 +393,11 @@ var tests = []*components.IntegrationTest{
 	sync.PushTag,
 	sync.PushWithCredentialPrompt,
 	sync.RenameBranchAndPull,
+	tag.BulkPushAndDelete,
 	tag.Checkout,
 	tag.CheckoutWhenBranchWithSameNameExists,
 	tag.CopyToClipboard,
+	tag.CreateReleaseTag,
 	tag.CreateWhileCommitting,
 	tag.CrudAnnotated,
 	tag.CrudLightweight,
@@ -370,6 +405,8 @@ var tests = []*components.IntegrationTest{
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
 	tag.Reset,
+	tag.ShowTagDetails,
+	tag.ShowTagsMissingOnUpstreamRemote,
 	ui.Accordion,
 	ui.DisableSwitchTabWithPanelJumpKeys,
 	ui.EmptyMenu,
@@ -379,8 +416,14 @@ var tests = []*components.IntegrationTest{
 	ui.RangeSelect,
 	ui.SwitchTabFromMenu,
 	ui.SwitchTabWithPanelJumpKeys,
+	undo.RollBackOperation,
+	undo.RollBackOperationOnBranchNamedLikeTag,
 	undo.UndoCheckoutAndDrop,
 	undo.UndoCommit,
+	undo.UndoDiscardAfterCheckout,
+	undo.UndoDiscardChanges,
+	undo.UndoDiscardKeepsOtherChanges,
+	undo.UndoDiscardLines,
 	undo.UndoDrop,
 	worktree.AddFromBranch,
 	worktree.AddFromBranchDetached,
@@ -400,6 +443,7 @@ var tests = []*components.IntegrationTest{
 	worktree.ForceRemoveWorktree,
 	worktree.RemoveWorktreeFromBranch,
 	worktree.ResetWindowTabs,
+	worktree.ShowWorktreeStatus,
 	worktree.SymlinkIntoRepoSubdir,
 	worktree.WorktreeInRepo,
 }
@@ -0,0 +1,100 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RollBackOperation = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Roll back specific actions from the operation history",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("other").
+			Checkout("master").
+			NewBranch("feature").
+			EmptyCommit("two").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+				Contains("other"),
+			).
+			NavigateToLine(Contains("feature")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Delete branch 'feature'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Force delete branch")).
+					Content(Equals("'feature' is not fully merged. Are you sure you want to delete it?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("other").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Universal.OpenOperationHistory)
+
+		t.Views().OperationHistory().
+			IsFocused().
+			Lines(
+				Contains("Checkout other").Contains("Checked out other (was master)").IsSelected(),
+				Contains("Delete local branch feature").Contains("feature: deleted (was at"),
+			).
+			NavigateToLine(Contains("Delete local branch feature")).
+			Tap(func() {
+				t.Views().Main().Content(Contains("feature: deleted (was at"))
+			}).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Roll back")).
+					Content(Contains("Are you sure you want to roll back 'Delete local branch feature'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("Roll back 'Delete local branch feature'").Contains("feature: created at").IsSelected(),
+				Contains("Checkout other"),
+				Contains("Delete local branch feature"),
+			).
+			NavigateToLine(Contains("feature: deleted")).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: Can't roll back because branch 'feature' has changed since."))
+			}).
+			NavigateToLine(Contains("Checkout other")).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Roll back")).
+					Content(Contains("Are you sure you want to roll back 'Checkout other'?")).
+					Confirm()
+			}).
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("other"),
+				Contains("feature"),
+			)
+
+		t.Views().Status().Content(Contains("repo → master"))
+	},
+})
@@ -0,0 +1,82 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RollBackOperationOnBranchNamedLikeTag = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Roll back the deletion of a branch that has the same name as a tag",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("one").
+			NewBranch("release").
+			EmptyCommit("two").
+			CreateLightweightTag("release", "HEAD").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("release"),
+			).
+			NavigateToLine(Contains("release")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Delete branch 'release'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Force delete branch")).
+					Content(Equals("'release' is not fully merged. Are you sure you want to delete it?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master").IsSelected(),
+			).
+			Press(keys.Universal.OpenOperationHistory)
+
+		t.Views().OperationHistory().
+			IsFocused().
+			Lines(
+				Contains("Delete local branch release").Contains("release: deleted (was at").IsSelected(),
+			).
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Roll back")).
+					Content(Contains("Are you sure you want to roll back 'Delete local branch release'?")).
+					Confirm()
+			}).
+			PressEscape()
+
+		t.Views().Branches().
+			IsFocused().
+			Lines(
+				Contains("master"),
+				Contains("release"),
+			)
+
+		// The branches panel would show refs/heads/heads/release as "release"
+		// too
+		t.Git().LocalBranchRefs([]string{"refs/heads/master", "refs/heads/release"})
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("release").Contains("two"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one"),
+			)
+	},
+})
@@ -0,0 +1,71 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDiscardAfterCheckout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Discard changes and then check out a branch with an older commit; undo undoes the checkout first and then the discard",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// The commit's date is long before the discard, but the checkout isn't
+		shell.EmptyCommitWithDate("old commit", "2020-01-01 10:00:00")
+		shell.NewBranch("old")
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("new commit")
+		shell.UpdateFile("file", "two\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M file").IsSelected(),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Discard changes")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			IsEmpty()
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("old")).
+			PressPrimaryAction().
+			Lines(
+				Contains("old").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to checkout 'master'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("old"),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to undo 'Discard all changes in selected file(s)'?")).
+					Confirm()
+			})
+
+		t.Views().Files().
+			Lines(
+				Contains(" M file"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("two\n"))
+	},
+})
@@ -0,0 +1,120 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDiscardChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Undo/redo discarding file changes, and interleave it with undoing a commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFileAndAdd("file", "two\n")
+		shell.UpdateFile("file", "three\n")
+		shell.CreateFile("untracked", "untracked\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("MM file").IsSelected(),
+				Contains("?? untracked"),
+			).
+			Press(keys.Files.ViewResetOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("")).
+					Select(Contains("Nuke working tree")).
+					Confirm()
+			}).
+			IsEmpty().
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to undo 'Nuke working tree'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("MM file"),
+				Contains("?? untracked"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("three\n"))
+		t.FileSystem().FileContent("untracked", Equals("untracked\n"))
+
+		t.Views().Files().
+			Press(keys.Universal.Redo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Redo")).
+					Content(Contains("Are you sure you want to redo 'Nuke working tree'?")).
+					Confirm()
+			}).
+			IsEmpty().
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to undo 'Nuke working tree'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("MM file"),
+				Contains("?? untracked"),
+			)
+
+		// Discard the untracked file, then commit the staged change; undoing
+		// should undo the commit first and the discard second.
+		t.Views().Files().
+			NavigateToLine(Contains("untracked")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Discard changes")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			Lines(
+				Contains("MM file"),
+			)
+
+		// Reflog timestamps only have a resolution of one second, so date the
+		// commit in the future to make sure it counts as the more recent action
+		t.Shell().RunCommandWithEnv(
+			[]string{"git", "commit", "-m", "second commit"},
+			[]string{"GIT_COMMITTER_DATE=2099-01-01T00:00:00"},
+		)
+
+		t.Views().Files().
+			Press(keys.Universal.Refresh).
+			Lines(
+				Contains(" M file"),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(MatchesRegexp(`Are you sure you want to soft reset to '.*'\?`)).
+					Confirm()
+			}).
+			Lines(
+				Contains("MM file"),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to undo 'Discard all changes in selected file(s)'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("MM file"),
+				Contains("?? untracked"),
+			)
+	},
+})
@@ -0,0 +1,75 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDiscardKeepsOtherChanges = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Undoing a discard only restores the discarded file, and keeps changes that were made to other files since",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("discarded", "one\n")
+		shell.CreateFileAndAdd("other", "one\n")
+		shell.Commit("first commit")
+		shell.UpdateFile("discarded", "two\n")
+		shell.UpdateFile("other", "two\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains(" M discarded").IsSelected(),
+				Contains(" M other"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Discard changes")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			Lines(
+				Contains(" M other").IsSelected(),
+			).
+			Tap(func() {
+				t.Shell().
+					UpdateFile("other", "three\n").
+					CreateFile("new", "new\n")
+			}).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to undo 'Discard all changes in selected file(s)'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains(" M discarded"),
+				Contains("?? new"),
+				Contains(" M other"),
+			)
+
+		t.FileSystem().FileContent("discarded", Equals("two\n"))
+		t.FileSystem().FileContent("other", Equals("three\n"))
+		t.FileSystem().FileContent("new", Equals("new\n"))
+
+		t.Views().Files().
+			Press(keys.Universal.Redo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Redo")).
+					Content(Contains("Are you sure you want to redo 'Discard all changes in selected file(s)'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("?? new"),
+				Contains(" M other"),
+			)
+
+		t.FileSystem().FileContent("discarded", Equals("one\n"))
+		t.FileSystem().FileContent("other", Equals("three\n"))
+	},
+})
@@ -0,0 +1,60 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDiscardLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Undo/redo discarding lines in the staging panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "one\ntwo\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file", "one\ntwo\nthree\nfour\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+three")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.Common().ConfirmDiscardLines()
+			}).
+			SelectedLines(Contains("+four")).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Contains("Are you sure you want to undo 'Discard selected lines'?")).
+					Confirm()
+			}).
+			ContainsLines(
+				Contains("+three"),
+				Contains("+four"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("one\ntwo\nthree\nfour\n"))
+
+		t.Views().Staging().
+			Press(keys.Universal.Redo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Redo")).
+					Content(Contains("Are you sure you want to redo 'Discard selected lines'?")).
+					Confirm()
+			})
+
+		t.FileSystem().FileContent("file", Equals("one\ntwo\nfour\n"))
+	},
+})
@@ -0,0 +1,46 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowWorktreeStatus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the branch, changed files and divergence from upstream of each worktree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("mybranch", "origin/mybranch")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		shell.SetBranchUpstream("newbranch", "origin/mybranch")
+		shell.RunCommand([]string{"git", "-C", "../linked-worktree", "commit", "--allow-empty", "-m", "linked commit"})
+		shell.CreateFile("../linked-worktree/file1", "content")
+		shell.CreateFile("../linked-worktree/file2", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").Contains("mybranch").Contains("✓").IsSelected(),
+				Contains("linked-worktree").Contains("newbranch").Contains("2 changed ↑1"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Changes:   clean")).
+			Content(Contains("Upstream:  up to date"))
+
+		t.Views().Worktrees().
+			NavigateToLine(Contains("linked-worktree"))
+
+		t.Views().Main().
+			Content(Contains("Changes:   2 changed")).
+			Content(Contains("Upstream:  1 ahead, 0 behind")).
+			Content(Contains("Untracked files:")).
+			Content(Contains("file1"))
+	},
+})
@@ -12,6 +12,7 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 func getCounter() (func(), func() int) {
@@ -268,3 +269,54 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 		}
 	}
 }
+
+func TestNewTaskRunsTasksInCreationOrder(t *testing.T) {
+	// The goroutines of tasks that are created in quick succession (e.g. when a
+	// view is rendered twice in a row) can get scheduled in any order; the task
+	// that was created last must still be the one whose output ends up in the
+	// view. Since the ordering is up to the scheduler we try this a few times.
+	for i := 0; i < 100; i++ {
+		tasks := []*gocui.FakeTask{}
+		manager := NewViewBufferManager(
+			utils.NewDummyLog(),
+			bytes.NewBuffer(nil),
+			func() {},
+			func() {},
+			func() {},
+			func() {},
+			func() gocui.Task {
+				task := gocui.NewFakeTask()
+				tasks = append(tasks, task)
+				return task
+			},
+		)
+
+		var mutex sync.Mutex
+		startedTasks := []string{}
+		newTask := func(name string) func(TaskOpts) error {
+			return func(TaskOpts) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				startedTasks = append(startedTasks, name)
+				return nil
+			}
+		}
+
+		_ = manager.NewTask(newTask("first"), "key")
+		_ = manager.NewTask(newTask("second"), "key")
+
+		deadline := time.Now().Add(time.Second)
+		for lo.SomeBy(tasks, func(task *gocui.FakeTask) bool { return task.Status() != gocui.TaskStatusDone }) {
+			if time.Now().After(deadline) {
+				t.Fatal("tasks didn't complete")
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		mutex.Lock()
+		if len(startedTasks) == 0 || startedTasks[len(startedTasks)-1] != "second" {
+			t.Fatalf("expected the second task to run last, but the tasks ran in this order: %v", startedTasks)
+		}
+		mutex.Unlock()
+	}
+}
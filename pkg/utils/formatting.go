@@ -209,3 +209,21 @@ func FormatPaths(paths []string) string {
 	}
 	return fmt.Sprintf("%s, %s, %s, [...%d more]", paths[0], paths[1], paths[2], len(paths)-3)
 }
+
+// Returns a human-readable representation of the given number of bytes, using
+// decimal units, e.g. "1.5 MB"
+func FormatBytes(size int64) string {
+	if size < 1000 {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	units := []string{"KB", "MB", "GB", "TB"}
+	value := float64(size) / 1000
+	unitIndex := 0
+	for value >= 1000 && unitIndex < len(units)-1 {
+		value /= 1000
+		unitIndex++
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[unitIndex])
+}
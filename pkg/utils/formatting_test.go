@@ -275,3 +275,24 @@ func BenchmarkStringWidthNonAsciiOptimized(b *testing.B) {
 		StringWidth("some non-ASCII string 🍉")
 	}
 }
+
+func TestFormatBytes(t *testing.T) {
+	type scenario struct {
+		size     int64
+		expected string
+	}
+
+	scenarios := []scenario{
+		{0, "0 B"},
+		{999, "999 B"},
+		{1000, "1.0 KB"},
+		{1536, "1.5 KB"},
+		{2048000, "2.0 MB"},
+		{3500000000, "3.5 GB"},
+		{4200000000000000, "4200.0 TB"},
+	}
+
+	for _, s := range scenarios {
+		assert.EqualValues(t, s.expected, FormatBytes(s.size))
+	}
+}
@@ -14,6 +14,15 @@ import (
 type Todo struct {
 	Hash string // for todos that have one, e.g. pick, drop, fixup, etc.
 	Ref  string // for update-ref todos
+
+	// For todos that have neither a hash nor a ref (exec, label, reset, and
+	// merge without a commit) we identify them by their command and text
+	// (see TodoText). Since there can be several identical ones (e.g. the same
+	// exec command after each commit), Occurrence tells which one it is,
+	// counting from the start of the file.
+	Command    todo.TodoCommand
+	Text       string
+	Occurrence int
 }
 
 type TodoChange struct {
@@ -58,7 +67,33 @@ func equalHash(a, b string) bool {
 	return commonLength > 0 && a[:commonLength] == b[:commonLength]
 }
 
+// Returns the argument of a todo that has no hash and no ref, e.g. the command
+// of an exec todo or the label name of a label todo
+func TodoText(t todo.Todo) string {
+	if t.Command == todo.Exec {
+		return t.ExecCommand
+	}
+	return t.Label
+}
+
+func isSameNonCommitTodo(t todo.Todo, other Todo) bool {
+	return t.Command == other.Command && t.Commit == "" && TodoText(t) == other.Text
+}
+
 func findTodo(todos []todo.Todo, todoToFind Todo) (int, bool) {
+	if todoToFind.Command != 0 {
+		occurrence := 0
+		for i, t := range todos {
+			if isSameNonCommitTodo(t, todoToFind) {
+				if occurrence == todoToFind.Occurrence {
+					return i, true
+				}
+				occurrence++
+			}
+		}
+		return -1, false
+	}
+
 	_, idx, ok := lo.FindIndexOf(todos, func(t todo.Todo) bool {
 		// For update-ref todos we also must compare the Ref (they have an empty hash)
 		return equalHash(t.Commit, todoToFind.Hash) && t.Ref == todoToFind.Ref
@@ -127,6 +162,9 @@ func DeleteTodos(fileName string, todosToDelete []Todo, commentChar byte) ([]byt
 }
 
 func deleteTodos(todos []todo.Todo, todosToDelete []Todo) ([]todo.Todo, error) {
+	// Find all of them before deleting any, so that deleting one doesn't
+	// change the occurrence count of another identical one
+	indices := []int{}
 	for _, todoToDelete := range todosToDelete {
 		idx, ok := findTodo(todos, todoToDelete)
 
@@ -135,6 +173,11 @@ func deleteTodos(todos []todo.Todo, todosToDelete []Todo) ([]todo.Todo, error) {
 			return []todo.Todo{}, fmt.Errorf("Todo %s not found in git-rebase-todo", todoToDelete.Hash)
 		}
 
+		indices = append(indices, idx)
+	}
+
+	slices.Sort(indices)
+	for _, idx := range lo.Reverse(indices) {
 		todos = Remove(todos, idx)
 	}
 
@@ -166,15 +209,29 @@ func MoveTodosUp(fileName string, todosToMove []Todo, isInRebase bool, commentCh
 }
 
 func moveTodoDown(todos []todo.Todo, todoToMove Todo, isInRebase bool) ([]todo.Todo, error) {
+	todoToMove = reverseOccurrence(todos, todoToMove)
 	rearrangedTodos, err := moveTodoUp(lo.Reverse(todos), todoToMove, isInRebase)
 	return lo.Reverse(rearrangedTodos), err
 }
 
 func moveTodosDown(todos []todo.Todo, todosToMove []Todo, isInRebase bool) ([]todo.Todo, error) {
+	todosToMove = lo.Map(todosToMove, func(t Todo, _ int) Todo { return reverseOccurrence(todos, t) })
 	rearrangedTodos, err := moveTodosUp(lo.Reverse(todos), lo.Reverse(todosToMove), isInRebase)
 	return lo.Reverse(rearrangedTodos), err
 }
 
+// Occurrences count from the start of the file, so when operating on the
+// reversed list of todos we need to count from the other end
+func reverseOccurrence(todos []todo.Todo, t Todo) Todo {
+	if t.Command == 0 {
+		return t
+	}
+
+	count := lo.CountBy(todos, func(other todo.Todo) bool { return isSameNonCommitTodo(other, t) })
+	t.Occurrence = count - 1 - t.Occurrence
+	return t
+}
+
 func moveTodoUp(todos []todo.Todo, todoToMove Todo, isInRebase bool) ([]todo.Todo, error) {
 	sourceIdx, ok := findTodo(todos, todoToMove)
 
@@ -285,10 +342,58 @@ func RemoveUpdateRefsForCopiedBranch(fileName string, commentChar byte) error {
 	return nil
 }
 
-// We render a todo in the commits view if it's a commit or if it's an
-// update-ref or exec. We don't render label, reset, or comment lines.
+// We render a todo in the commits view if it's a commit. When in a rebase, we
+// also render update-ref, exec, label, reset, and merge todos (the latter only
+// have a commit if they re-create an existing merge). We never render comment
+// lines, nor the label and reset todos for the rebase base (see IsOntoTodo).
 func isRenderedTodo(t todo.Todo, isInRebase bool) bool {
-	return t.Commit != "" || (isInRebase && (t.Command == todo.UpdateRef || t.Command == todo.Exec))
+	return t.Commit != "" || (isInRebase && IsRenderedNonCommitTodo(t.Command) && !IsOntoTodo(t))
+}
+
+func IsRenderedNonCommitTodo(command todo.TodoCommand) bool {
+	return command == todo.UpdateRef || command == todo.Exec || command == todo.Label ||
+		command == todo.Reset || command == todo.Merge
+}
+
+// With --rebase-merges, git starts the todo list with `label onto` and resets
+// to that label for every branch that starts at the rebase base. These are
+// implementation details of the rebase that would otherwise clutter every
+// rebase we start, so we don't show them.
+func IsOntoTodo(t todo.Todo) bool {
+	return (t.Command == todo.Label || t.Command == todo.Reset) && t.Label == "onto"
+}
+
+// Inserts an exec todo with the given command after each of the given todos
+func InsertExecTodos(fileName string, todosToFollow []Todo, command string, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+	newTodos, err := insertExecTodos(todos, todosToFollow, command)
+	if err != nil {
+		return err
+	}
+	return WriteRebaseTodoFile(fileName, newTodos, commentChar)
+}
+
+func insertExecTodos(todos []todo.Todo, todosToFollow []Todo, command string) ([]todo.Todo, error) {
+	indices := []int{}
+	for _, todoToFollow := range todosToFollow {
+		idx, ok := findTodo(todos, todoToFollow)
+		if !ok {
+			// Should never happen
+			return nil, fmt.Errorf("Todo %s not found in git-rebase-todo", todoToFollow.Hash)
+		}
+		indices = append(indices, idx)
+	}
+
+	// Insert from the back so that the remaining indices stay valid
+	slices.Sort(indices)
+	for _, idx := range lo.Reverse(indices) {
+		todos = slices.Insert(todos, idx+1, todo.Todo{Command: todo.Exec, ExecCommand: command})
+	}
+
+	return todos, nil
 }
 
 func DropMergeCommit(fileName string, hash string, commentChar byte) error {
@@ -113,6 +113,78 @@ func TestRebaseCommands_moveTodoDown(t *testing.T) {
 				{Command: todo.Exec, ExecCommand: "make test"},
 			},
 		},
+		{
+			testName: "move exec todo in rebase",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			todoToMoveDown: Todo{Command: todo.Exec, Text: "make test"},
+			isInRebase:     true,
+			expectedErr:    "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+		},
+		{
+			testName: "move second of two identical exec todos",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Pick, Commit: "abcd"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			todoToMoveDown: Todo{Command: todo.Exec, Text: "make test", Occurrence: 1},
+			isInRebase:     true,
+			expectedErr:    "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "abcd"},
+			},
+		},
+		{
+			testName: "move label todo in rebase",
+			todos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Label, Label: "feature"},
+			},
+			todoToMoveDown: Todo{Command: todo.Label, Text: "feature"},
+			isInRebase:     true,
+			expectedErr:    "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Label, Label: "feature"},
+				{Command: todo.Pick, Commit: "1234"},
+			},
+		},
+		{
+			testName: "skip the todos for the rebase base",
+			todos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Reset, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Reset, Label: "onto"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+			todoToMoveDown: Todo{Hash: "5678"},
+			isInRebase:     true,
+			expectedErr:    "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Reset, Label: "onto"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Reset, Label: "onto"},
+			},
+		},
 		{
 			testName: "skip an invisible todo",
 			todos: []todo.Todo{
@@ -288,6 +360,40 @@ func TestRebaseCommands_moveTodoUp(t *testing.T) {
 				{Command: todo.Pick, Commit: "5678"},
 			},
 		},
+		{
+			testName: "move first of two identical exec todos",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			todoToMoveUp: Todo{Command: todo.Exec, Text: "make test", Occurrence: 0},
+			isInRebase:   true,
+			expectedErr:  "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+		},
+		{
+			testName: "move reset todo across merge todo in rebase",
+			todos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Reset, Label: "onto"},
+				{Command: todo.Merge, Flag: "-C", Commit: "abcd", Label: "feature"},
+			},
+			todoToMoveUp: Todo{Command: todo.Reset, Text: "onto"},
+			isInRebase:   true,
+			expectedErr:  "",
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Merge, Flag: "-C", Commit: "abcd", Label: "feature"},
+				{Command: todo.Reset, Label: "onto"},
+			},
+		},
 		{
 			testName: "skip an invisible todo",
 			todos: []todo.Todo{
@@ -524,6 +630,28 @@ func TestRebaseCommands_deleteTodos(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "identical exec todos",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "abcd"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			todosToDelete: []Todo{
+				{Command: todo.Exec, Text: "make test", Occurrence: 0},
+				{Command: todo.Exec, Text: "make test", Occurrence: 2},
+			},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "abcd"},
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "failure",
 			todos: []todo.Todo{
@@ -553,6 +681,66 @@ func TestRebaseCommands_deleteTodos(t *testing.T) {
 	}
 }
 
+func TestRebaseCommands_insertExecTodos(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		todos         []todo.Todo
+		todosToFollow []Todo
+		expectedTodos []todo.Todo
+		expectedErr   error
+	}{
+		{
+			name: "after several todos",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Label, Label: "feature"},
+				{Command: todo.Pick, Commit: "abcd"},
+			},
+			todosToFollow: []Todo{
+				{Hash: "abcd"},
+				{Hash: "1234"},
+				{Command: todo.Label, Text: "feature"},
+			},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Label, Label: "feature"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+				{Command: todo.Pick, Commit: "abcd"},
+				{Command: todo.Exec, ExecCommand: "make test"},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "failure",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+			},
+			todosToFollow: []Todo{
+				{Hash: "abcd"},
+			},
+			expectedTodos: nil,
+			expectedErr:   errors.New("Todo abcd not found in git-rebase-todo"),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos, actualErr := insertExecTodos(scenario.todos, scenario.todosToFollow, "make test")
+
+			if scenario.expectedErr == nil {
+				assert.NoError(t, actualErr)
+			} else {
+				assert.EqualError(t, actualErr, scenario.expectedErr.Error())
+			}
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
+
 func Test_equalHash(t *testing.T) {
 	scenarios := []struct {
 		a        string
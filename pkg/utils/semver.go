@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a semantic version as found in release tag names, e.g. 1.2.3 or
+// 1.2.3-rc.1. Build metadata (the +foo suffix) is not supported.
+type SemVer struct {
+	Major int
+	Minor int
+	Patch int
+	// e.g. "rc.1". Empty for a stable release
+	PreRelease string
+}
+
+var semVerRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// ParseSemVer parses a tag name like v1.2.3 as a semantic version, after
+// stripping the given prefix (e.g. "v") from it
+func ParseSemVer(tagName string, prefix string) (SemVer, bool) {
+	version, found := strings.CutPrefix(tagName, prefix)
+	if !found {
+		return SemVer{}, false
+	}
+
+	match := semVerRegex.FindStringSubmatch(version)
+	if match == nil {
+		return SemVer{}, false
+	}
+
+	numbers := make([]int, 3)
+	for i := range numbers {
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			// too large to fit in an int
+			return SemVer{}, false
+		}
+		numbers[i] = n
+	}
+
+	return SemVer{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		PreRelease: match[4],
+	}, true
+}
+
+// LatestSemVer returns the highest version among the tag names that parse as
+// semantic versions with the given prefix, along with the name of its tag
+func LatestSemVer(tagNames []string, prefix string) (SemVer, string, bool) {
+	var latest SemVer
+	latestTagName := ""
+	for _, tagName := range tagNames {
+		version, ok := ParseSemVer(tagName, prefix)
+		if !ok {
+			continue
+		}
+		if latestTagName == "" || version.Compare(latest) > 0 {
+			latest = version
+			latestTagName = tagName
+		}
+	}
+
+	return latest, latestTagName, latestTagName != ""
+}
+
+func (self SemVer) String() string {
+	str := fmt.Sprintf("%d.%d.%d", self.Major, self.Minor, self.Patch)
+	if self.PreRelease != "" {
+		str += "-" + self.PreRelease
+	}
+	return str
+}
+
+func (self SemVer) IsPreRelease() bool {
+	return self.PreRelease != ""
+}
+
+// Compare returns -1, 0 or 1 depending on whether self has lower, equal or
+// higher precedence than other, following the rules of semver.org
+func (self SemVer) Compare(other SemVer) int {
+	for _, pair := range [][2]int{
+		{self.Major, other.Major},
+		{self.Minor, other.Minor},
+		{self.Patch, other.Patch},
+	} {
+		if pair[0] != pair[1] {
+			return compareInts(pair[0], pair[1])
+		}
+	}
+
+	// A pre-release comes before the release it's a pre-release of
+	if self.PreRelease == "" || other.PreRelease == "" {
+		return compareInts(len(other.PreRelease), len(self.PreRelease))
+	}
+
+	selfIdentifiers := strings.Split(self.PreRelease, ".")
+	otherIdentifiers := strings.Split(other.PreRelease, ".")
+	for i := 0; i < len(selfIdentifiers) && i < len(otherIdentifiers); i++ {
+		if result := comparePreReleaseIdentifiers(selfIdentifiers[i], otherIdentifiers[i]); result != 0 {
+			return result
+		}
+	}
+
+	return compareInts(len(selfIdentifiers), len(otherIdentifiers))
+}
+
+// The version that releases a fix on top of self. A pre-release is followed by
+// the release it's a pre-release of.
+func (self SemVer) NextPatch() SemVer {
+	if self.IsPreRelease() {
+		return SemVer{Major: self.Major, Minor: self.Minor, Patch: self.Patch}
+	}
+	return SemVer{Major: self.Major, Minor: self.Minor, Patch: self.Patch + 1}
+}
+
+func (self SemVer) NextMinor() SemVer {
+	if self.IsPreRelease() && self.Patch == 0 {
+		return SemVer{Major: self.Major, Minor: self.Minor}
+	}
+	return SemVer{Major: self.Major, Minor: self.Minor + 1}
+}
+
+func (self SemVer) NextMajor() SemVer {
+	if self.IsPreRelease() && self.Minor == 0 && self.Patch == 0 {
+		return SemVer{Major: self.Major}
+	}
+	return SemVer{Major: self.Major + 1}
+}
+
+// NextPreRelease bumps the number at the end of an existing pre-release (e.g.
+// rc.1 -> rc.2), or starts a pre-release of the next patch version using the
+// given identifier (e.g. 1.2.3 -> 1.2.4-rc.1)
+func (self SemVer) NextPreRelease(identifier string) SemVer {
+	if !self.IsPreRelease() {
+		next := self.NextPatch()
+		next.PreRelease = identifier + ".1"
+		return next
+	}
+
+	next := self
+	identifiers := strings.Split(self.PreRelease, ".")
+	last := identifiers[len(identifiers)-1]
+	if n, err := strconv.Atoi(last); err == nil {
+		identifiers[len(identifiers)-1] = strconv.Itoa(n + 1)
+		next.PreRelease = strings.Join(identifiers, ".")
+	} else {
+		next.PreRelease += ".1"
+	}
+	return next
+}
+
+// Numeric identifiers are compared numerically and come before alphanumeric
+// ones, which are compared lexically
+func comparePreReleaseIdentifiers(a string, b string) int {
+	aNumber, aErr := strconv.Atoi(a)
+	bNumber, bErr := strconv.Atoi(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareInts(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInts(a int, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
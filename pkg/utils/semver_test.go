@@ -0,0 +1,215 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemVer(t *testing.T) {
+	type scenario struct {
+		testName   string
+		tagName    string
+		prefix     string
+		expected   SemVer
+		expectedOk bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "with prefix",
+			tagName:    "v1.2.3",
+			prefix:     "v",
+			expected:   SemVer{Major: 1, Minor: 2, Patch: 3},
+			expectedOk: true,
+		},
+		{
+			testName:   "without prefix",
+			tagName:    "1.2.3",
+			prefix:     "",
+			expected:   SemVer{Major: 1, Minor: 2, Patch: 3},
+			expectedOk: true,
+		},
+		{
+			testName:   "pre-release",
+			tagName:    "v10.0.0-rc.2",
+			prefix:     "v",
+			expected:   SemVer{Major: 10, Minor: 0, Patch: 0, PreRelease: "rc.2"},
+			expectedOk: true,
+		},
+		{
+			testName:   "missing prefix",
+			tagName:    "1.2.3",
+			prefix:     "v",
+			expectedOk: false,
+		},
+		{
+			testName:   "prefix where none is expected",
+			tagName:    "v1.2.3",
+			prefix:     "",
+			expectedOk: false,
+		},
+		{
+			testName:   "missing patch version",
+			tagName:    "v1.2",
+			prefix:     "v",
+			expectedOk: false,
+		},
+		{
+			testName:   "leading zero",
+			tagName:    "v1.02.3",
+			prefix:     "v",
+			expectedOk: false,
+		},
+		{
+			testName:   "build metadata",
+			tagName:    "v1.2.3+build.4",
+			prefix:     "v",
+			expectedOk: false,
+		},
+		{
+			testName:   "not a version",
+			tagName:    "release-candidate",
+			prefix:     "",
+			expectedOk: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			version, ok := ParseSemVer(s.tagName, s.prefix)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expected, version)
+		})
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	// in ascending order of precedence, as listed on semver.org
+	versions := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+	}
+
+	for i, a := range versions {
+		for j, b := range versions {
+			aVersion, _ := ParseSemVer(a, "")
+			bVersion, _ := ParseSemVer(b, "")
+			assert.Equal(t, compareInts(i, j), aVersion.Compare(bVersion), "comparing %s to %s", a, b)
+		}
+	}
+}
+
+func TestLatestSemVer(t *testing.T) {
+	type scenario struct {
+		testName        string
+		tagNames        []string
+		expected        SemVer
+		expectedTagName string
+		expectedOk      bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:   "no tags",
+			tagNames:   []string{},
+			expectedOk: false,
+		},
+		{
+			testName:   "no version tags",
+			tagNames:   []string{"latest", "1.2.3"},
+			expectedOk: false,
+		},
+		{
+			testName:        "picks the highest version regardless of order",
+			tagNames:        []string{"v1.9.0", "latest", "v1.10.0", "v1.10.0-rc.1", "v0.9.9"},
+			expected:        SemVer{Major: 1, Minor: 10, Patch: 0},
+			expectedTagName: "v1.10.0",
+			expectedOk:      true,
+		},
+		{
+			testName:        "pre-release of a newer version",
+			tagNames:        []string{"v1.2.3", "v1.3.0-beta.1"},
+			expected:        SemVer{Major: 1, Minor: 3, Patch: 0, PreRelease: "beta.1"},
+			expectedTagName: "v1.3.0-beta.1",
+			expectedOk:      true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			version, tagName, ok := LatestSemVer(s.tagNames, "v")
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expected, version)
+			assert.Equal(t, s.expectedTagName, tagName)
+		})
+	}
+}
+
+func TestSemVerNextVersions(t *testing.T) {
+	type scenario struct {
+		version            string
+		expectedPatch      string
+		expectedMinor      string
+		expectedMajor      string
+		expectedPreRelease string
+	}
+
+	scenarios := []scenario{
+		{
+			version:            "0.0.0",
+			expectedPatch:      "0.0.1",
+			expectedMinor:      "0.1.0",
+			expectedMajor:      "1.0.0",
+			expectedPreRelease: "0.0.1-rc.1",
+		},
+		{
+			version:            "1.2.3",
+			expectedPatch:      "1.2.4",
+			expectedMinor:      "1.3.0",
+			expectedMajor:      "2.0.0",
+			expectedPreRelease: "1.2.4-rc.1",
+		},
+		{
+			version:            "1.2.3-rc.1",
+			expectedPatch:      "1.2.3",
+			expectedMinor:      "1.3.0",
+			expectedMajor:      "2.0.0",
+			expectedPreRelease: "1.2.3-rc.2",
+		},
+		{
+			version:            "1.3.0-beta.9",
+			expectedPatch:      "1.3.0",
+			expectedMinor:      "1.3.0",
+			expectedMajor:      "2.0.0",
+			expectedPreRelease: "1.3.0-beta.10",
+		},
+		{
+			version:            "2.0.0-alpha",
+			expectedPatch:      "2.0.0",
+			expectedMinor:      "2.0.0",
+			expectedMajor:      "2.0.0",
+			expectedPreRelease: "2.0.0-alpha.1",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.version, func(t *testing.T) {
+			version, ok := ParseSemVer(s.version, "")
+			assert.True(t, ok)
+			assert.Equal(t, s.expectedPatch, version.NextPatch().String())
+			assert.Equal(t, s.expectedMinor, version.NextMinor().String())
+			assert.Equal(t, s.expectedMajor, version.NextMajor().String())
+			assert.Equal(t, s.expectedPreRelease, version.NextPreRelease("rc").String())
+		})
+	}
+}